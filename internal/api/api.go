@@ -6,20 +6,38 @@ import (
 )
 
 type API struct {
-	Application ApplicationAPI
-	APIResource ResourceAPI
-	httpClient  HTTPClient
+	Application   ApplicationAPI
+	APIResource   ResourceAPI
+	User          UserAPI
+	Group         GroupAPI
+	Role          RoleAPI
+	Bulk          BulkAPI
+	IDP           IdentityProviderAPI
+	Authenticator AuthenticatorAPI
+	Claim         ClaimAPI
+	OIDCScope     OIDCScopeAPI
+	Organization  OrganizationAPI
+	httpClient    HTTPClient
 }
 
-func NewAPI(cfg *config.Config, tenantDomain string, logger *zap.Logger) (*API, error) {
-	httpClient, err := NewHTTPClientAPI(cfg, tenantDomain, logger)
+func NewAPI(cfg *config.Config, tenantDomain, organizationID string, retry RetryPolicy, logger *zap.Logger) (*API, error) {
+	httpClient, err := NewHTTPClientAPI(cfg, tenantDomain, organizationID, retry, logger)
 	if err != nil {
 		return nil, err
 	}
 	api := &API{
-		httpClient:  httpClient,
-		Application: NewApplicationAPI(httpClient),
-		APIResource: NewApiResourceAPI(httpClient),
+		httpClient:    httpClient,
+		Application:   NewApplicationAPI(httpClient),
+		APIResource:   NewApiResourceAPI(httpClient),
+		User:          NewUserAPI(httpClient),
+		Group:         NewGroupAPI(httpClient),
+		Role:          NewRoleAPI(httpClient),
+		Bulk:          NewBulkAPI(httpClient),
+		IDP:           NewIdentityProviderAPI(httpClient),
+		Authenticator: NewAuthenticatorAPI(httpClient),
+		Claim:         NewClaimAPI(httpClient),
+		OIDCScope:     NewOIDCScopeAPI(httpClient),
+		Organization:  NewOrganizationAPI(httpClient),
 	}
 	return api, nil
 }
@@ -13,21 +13,46 @@ type apiResourceAPI struct {
 
 type ResourceAPI interface {
 	List(ctx context.Context, apiType string) (list *models.APIResourceList, err error)
+	Paginate(apiType string, pageSize int) *Paginator[models.APIResource]
 	Get(ctx context.Context, id string) (apiResource *models.APIResource, err error)
 	Create(ctx context.Context, apiResource map[string]interface{}) (err error)
+	Patch(ctx context.Context, id string, patch map[string]interface{}) (err error)
 	Delete(ctx context.Context, id string) (err error)
+	PutScopes(ctx context.Context, id string, scopes []models.Scope) (err error)
+	DeleteScope(ctx context.Context, id, scopeName string) (err error)
+	ListScopes(ctx context.Context, filter string) (scopes []models.Scope, err error)
 }
 
 func NewApiResourceAPI(httpClient HTTPClient) ResourceAPI {
 	return &apiResourceAPI{httpClient: httpClient}
 }
 
+// List returns every api resource of the type, fetching all the pages.
 func (api *apiResourceAPI) List(ctx context.Context, apiType string) (list *models.APIResourceList, err error) {
+	apiResources, err := api.Paginate(apiType, DefaultPageSize).All(ctx, 0)
+	if err != nil {
+		return nil, err
+	}
+	return &models.APIResourceList{TotalResults: len(apiResources), APIResources: apiResources}, nil
+}
+
+// Paginate returns a paginator over the api resources of the type. The endpoint is cursor based,
+// so the pages are followed through their next links.
+func (api *apiResourceAPI) Paginate(apiType string, pageSize int) *Paginator[models.APIResource] {
 	params := url.Values{}
 	params.Add("attributes", "properties")
 	params.Add("filter", "type eq "+apiType)
-	err = api.httpClient.Request(ctx, "GET", api.httpClient.URI("api-resources"), WithParams(params), WithPayload(&list))
-	return
+	return newPaginator(api.httpClient, api.httpClient.URI("api-resources"), params, pageSize, cursorPaging,
+		func(ctx context.Context, uri string, params url.Values) (*Page[models.APIResource], error) {
+			var list *models.APIResourceList
+			if err := api.httpClient.Request(ctx, "GET", uri, WithParams(params), WithPayload(&list)); err != nil {
+				return nil, err
+			}
+			if list == nil {
+				return nil, nil
+			}
+			return &Page[models.APIResource]{Items: list.APIResources, Links: list.Links, TotalResults: list.TotalResults}, nil
+		})
 }
 
 func (api *apiResourceAPI) Get(ctx context.Context, id string) (apiResource *models.APIResource, err error) {
@@ -40,7 +65,34 @@ func (api *apiResourceAPI) Create(ctx context.Context, apiResource map[string]in
 	return
 }
 
+func (api *apiResourceAPI) Patch(ctx context.Context, id string, patch map[string]interface{}) (err error) {
+	err = api.httpClient.Request(ctx, "PATCH", api.httpClient.URI("api-resources", id), WithPayload(patch))
+	return
+}
+
 func (api *apiResourceAPI) Delete(ctx context.Context, id string) (err error) {
 	err = api.httpClient.Request(ctx, "DELETE", api.httpClient.URI("api-resources", id))
 	return
 }
+
+// PutScopes replaces the scopes of an api resource.
+func (api *apiResourceAPI) PutScopes(ctx context.Context, id string, scopes []models.Scope) (err error) {
+	err = api.httpClient.Request(ctx, "PUT", api.httpClient.URI("api-resources", id, "scopes"), WithPayload(scopes))
+	return
+}
+
+func (api *apiResourceAPI) DeleteScope(ctx context.Context, id, scopeName string) (err error) {
+	err = api.httpClient.Request(ctx, "DELETE", api.httpClient.URI("api-resources", id, "scopes", scopeName))
+	return
+}
+
+// ListScopes returns the scopes of every api resource of the tenant that match the filter, for
+// example 'name eq read_loans'.
+func (api *apiResourceAPI) ListScopes(ctx context.Context, filter string) (scopes []models.Scope, err error) {
+	params := url.Values{}
+	if filter != "" {
+		params.Add("filter", filter)
+	}
+	err = api.httpClient.Request(ctx, "GET", api.httpClient.URI("scopes"), WithParams(params), WithPayload(&scopes))
+	return
+}
@@ -2,6 +2,8 @@ package api
 
 import (
 	"context"
+	"net/http"
+	"net/url"
 
 	"github.com/shashimalcse/asgardeo-cli/internal/models"
 )
@@ -12,22 +14,68 @@ type applicationAPI struct {
 
 type ApplicationAPI interface {
 	List(ctx context.Context) (list *models.ApplicationList, err error)
-	Create(ctx context.Context, application map[string]interface{}) (err error)
+	Paginate(pageSize int) *Paginator[models.Application]
+	Get(ctx context.Context, id string) (application *models.Application, err error)
+	Create(ctx context.Context, application map[string]interface{}) (id string, err error)
+	Patch(ctx context.Context, id string, application map[string]interface{}) (err error)
 	Delete(ctx context.Context, id string) (err error)
+	GetOIDCInboundProtocol(ctx context.Context, id string) (oidc *models.OIDC, err error)
+	GetSAMLInboundProtocol(ctx context.Context, id string) (saml *models.SAML, err error)
+	GetInboundProtocol(ctx context.Context, id, protocol string) (config map[string]interface{}, err error)
+	UpdateInboundProtocol(ctx context.Context, id, protocol string, config map[string]interface{}) (err error)
+	ListAuthorizedAPIs(ctx context.Context, id string) (authorizedAPIs []models.AuthorizedAPI, err error)
+	AuthorizeAPI(ctx context.Context, id string, authorizedAPI *models.AuthorizedAPICreate) (err error)
+	PatchAuthorizedAPI(ctx context.Context, id, apiID string, patch *models.AuthorizedAPIPatch) (err error)
+	RevokeAPI(ctx context.Context, id, apiID string) (err error)
 }
 
 func NewApplicationAPI(httpClient HTTPClient) ApplicationAPI {
 	return &applicationAPI{httpClient: httpClient}
 }
 
+// List returns every application, fetching all the pages.
 func (api *applicationAPI) List(ctx context.Context) (list *models.ApplicationList, err error) {
-	err = api.httpClient.Request(ctx, "GET", api.httpClient.URI("applications"), WithPayload(&list))
+	paginator := api.Paginate(DefaultPageSize)
+	applications, err := paginator.All(ctx, 0)
+	if err != nil {
+		return nil, err
+	}
+	return &models.ApplicationList{TotalResults: len(applications), StartIndex: 1, Count: len(applications), Applications: applications}, nil
+}
+
+// Paginate returns a paginator over the applications, fetching pageSize applications per request.
+func (api *applicationAPI) Paginate(pageSize int) *Paginator[models.Application] {
+	return newPaginator(api.httpClient, api.httpClient.URI("applications"), nil, pageSize, offsetPaging,
+		func(ctx context.Context, uri string, params url.Values) (*Page[models.Application], error) {
+			var list *models.ApplicationList
+			if err := api.httpClient.Request(ctx, "GET", uri, WithParams(params), WithPayload(&list)); err != nil {
+				return nil, err
+			}
+			if list == nil {
+				return nil, nil
+			}
+			return &Page[models.Application]{Items: list.Applications, Links: list.Links, TotalResults: list.TotalResults}, nil
+		})
+}
+
+func (api *applicationAPI) Get(ctx context.Context, id string) (application *models.Application, err error) {
+	err = api.httpClient.Request(ctx, "GET", api.httpClient.URI("applications", id), WithPayload(&application))
 	return
 }
 
-func (api *applicationAPI) Create(ctx context.Context, application map[string]interface{}) (err error) {
+// Create creates an application and returns the ID of the created application.
+func (api *applicationAPI) Create(ctx context.Context, application map[string]interface{}) (id string, err error) {
+	var header http.Header
 	err = api.httpClient.Request(ctx, "POST", api.httpClient.URI("applications"),
-		WithPayload(application))
+		WithPayload(application), WithResponseHeader(&header))
+	if err != nil {
+		return "", err
+	}
+	return resourceIDFromLocation(header)
+}
+
+func (api *applicationAPI) Patch(ctx context.Context, id string, application map[string]interface{}) (err error) {
+	err = api.httpClient.Request(ctx, "PATCH", api.httpClient.URI("applications", id), WithPayload(application))
 	return
 }
 
@@ -35,3 +83,48 @@ func (api *applicationAPI) Delete(ctx context.Context, id string) (err error) {
 	err = api.httpClient.Request(ctx, "DELETE", api.httpClient.URI("applications", id))
 	return
 }
+
+func (api *applicationAPI) GetOIDCInboundProtocol(ctx context.Context, id string) (oidc *models.OIDC, err error) {
+	err = api.httpClient.Request(ctx, "GET", api.httpClient.URI("applications", id, "inbound-protocols", "oidc"), WithPayload(&oidc))
+	return
+}
+
+func (api *applicationAPI) GetSAMLInboundProtocol(ctx context.Context, id string) (saml *models.SAML, err error) {
+	err = api.httpClient.Request(ctx, "GET", api.httpClient.URI("applications", id, "inbound-protocols", "saml"), WithPayload(&saml))
+	return
+}
+
+// GetInboundProtocol returns the raw configuration of an inbound protocol, keeping the fields that are not modelled.
+func (api *applicationAPI) GetInboundProtocol(ctx context.Context, id, protocol string) (config map[string]interface{}, err error) {
+	err = api.httpClient.Request(ctx, "GET", api.httpClient.URI("applications", id, "inbound-protocols", protocol), WithPayload(&config))
+	return
+}
+
+// UpdateInboundProtocol replaces the configuration of an inbound protocol.
+func (api *applicationAPI) UpdateInboundProtocol(ctx context.Context, id, protocol string, config map[string]interface{}) (err error) {
+	err = api.httpClient.Request(ctx, "PUT", api.httpClient.URI("applications", id, "inbound-protocols", protocol), WithPayload(config))
+	return
+}
+
+func (api *applicationAPI) ListAuthorizedAPIs(ctx context.Context, id string) (authorizedAPIs []models.AuthorizedAPI, err error) {
+	err = api.httpClient.Request(ctx, "GET", api.httpClient.URI("applications", id, "authorized-apis"), WithPayload(&authorizedAPIs))
+	return
+}
+
+// AuthorizeAPI authorizes the application to access an api resource with the given scopes.
+func (api *applicationAPI) AuthorizeAPI(ctx context.Context, id string, authorizedAPI *models.AuthorizedAPICreate) (err error) {
+	err = api.httpClient.Request(ctx, "POST", api.httpClient.URI("applications", id, "authorized-apis"), WithPayload(authorizedAPI))
+	return
+}
+
+// PatchAuthorizedAPI adds and removes scopes of an api resource the application is authorized to access.
+func (api *applicationAPI) PatchAuthorizedAPI(ctx context.Context, id, apiID string, patch *models.AuthorizedAPIPatch) (err error) {
+	err = api.httpClient.Request(ctx, "PATCH", api.httpClient.URI("applications", id, "authorized-apis", apiID), WithPayload(patch))
+	return
+}
+
+// RevokeAPI removes the access of the application to an api resource.
+func (api *applicationAPI) RevokeAPI(ctx context.Context, id, apiID string) (err error) {
+	err = api.httpClient.Request(ctx, "DELETE", api.httpClient.URI("applications", id, "authorized-apis", apiID))
+	return
+}
@@ -0,0 +1,25 @@
+package api
+
+import (
+	"context"
+
+	"github.com/shashimalcse/asgardeo-cli/internal/models"
+)
+
+type authenticatorAPI struct {
+	httpClient HTTPClient
+}
+
+type AuthenticatorAPI interface {
+	List(ctx context.Context) (authenticators []models.Authenticator, err error)
+}
+
+func NewAuthenticatorAPI(httpClient HTTPClient) AuthenticatorAPI {
+	return &authenticatorAPI{httpClient: httpClient}
+}
+
+// List returns the local authenticators and the identity providers of the tenant.
+func (api *authenticatorAPI) List(ctx context.Context) (authenticators []models.Authenticator, err error) {
+	err = api.httpClient.Request(ctx, "GET", api.httpClient.URI("authenticators"), WithPayload(&authenticators))
+	return
+}
@@ -0,0 +1,27 @@
+package api
+
+import (
+	"context"
+
+	"github.com/shashimalcse/asgardeo-cli/internal/models"
+)
+
+type bulkAPI struct {
+	httpClient HTTPClient
+}
+
+type BulkAPI interface {
+	Send(ctx context.Context, operations []models.BulkOperation) (response *models.BulkResponse, err error)
+}
+
+func NewBulkAPI(httpClient HTTPClient) BulkAPI {
+	return &bulkAPI{httpClient: httpClient}
+}
+
+// Send sends the operations in a single SCIM bulk request. Every operation is processed, the
+// failures are reported in the status of each operation response.
+func (api *bulkAPI) Send(ctx context.Context, operations []models.BulkOperation) (response *models.BulkResponse, err error) {
+	request := &models.BulkRequest{Schemas: []string{models.SCIMBulkRequestSchema}, Operations: operations}
+	err = api.httpClient.Request(ctx, "POST", api.httpClient.SCIMURI("Bulk"), WithPayload(request), WithResponse(&response))
+	return
+}
@@ -0,0 +1,94 @@
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/shashimalcse/asgardeo-cli/internal/models"
+)
+
+// LocalDialectID is the ID of the dialect of the local claims.
+const LocalDialectID = "local"
+
+type claimAPI struct {
+	httpClient HTTPClient
+}
+
+type ClaimAPI interface {
+	ListDialects(ctx context.Context) (dialects []models.ClaimDialect, err error)
+	ListLocalClaims(ctx context.Context) (claims []models.LocalClaim, err error)
+	GetLocalClaim(ctx context.Context, id string) (claim *models.LocalClaim, err error)
+	CreateLocalClaim(ctx context.Context, claim *models.LocalClaim) (id string, err error)
+	UpdateLocalClaim(ctx context.Context, id string, claim *models.LocalClaim) (err error)
+	DeleteLocalClaim(ctx context.Context, id string) (err error)
+	ListExternalClaims(ctx context.Context, dialectID string) (claims []models.ExternalClaim, err error)
+	CreateExternalClaim(ctx context.Context, dialectID string, claim *models.ExternalClaim) (id string, err error)
+	UpdateExternalClaim(ctx context.Context, dialectID, id string, claim *models.ExternalClaim) (err error)
+	DeleteExternalClaim(ctx context.Context, dialectID, id string) (err error)
+}
+
+func NewClaimAPI(httpClient HTTPClient) ClaimAPI {
+	return &claimAPI{httpClient: httpClient}
+}
+
+func (api *claimAPI) ListDialects(ctx context.Context) (dialects []models.ClaimDialect, err error) {
+	err = api.httpClient.Request(ctx, "GET", api.httpClient.URI("claim-dialects"), WithPayload(&dialects))
+	return
+}
+
+func (api *claimAPI) ListLocalClaims(ctx context.Context) (claims []models.LocalClaim, err error) {
+	err = api.httpClient.Request(ctx, "GET", api.httpClient.URI("claim-dialects", LocalDialectID, "claims"), WithPayload(&claims))
+	return
+}
+
+func (api *claimAPI) GetLocalClaim(ctx context.Context, id string) (claim *models.LocalClaim, err error) {
+	err = api.httpClient.Request(ctx, "GET", api.httpClient.URI("claim-dialects", LocalDialectID, "claims", id), WithPayload(&claim))
+	return
+}
+
+func (api *claimAPI) CreateLocalClaim(ctx context.Context, claim *models.LocalClaim) (id string, err error) {
+	var header http.Header
+	err = api.httpClient.Request(ctx, "POST", api.httpClient.URI("claim-dialects", LocalDialectID, "claims"),
+		WithPayload(claim), WithResponseHeader(&header))
+	if err != nil {
+		return "", err
+	}
+	return resourceIDFromLocation(header)
+}
+
+// UpdateLocalClaim replaces the local claim.
+func (api *claimAPI) UpdateLocalClaim(ctx context.Context, id string, claim *models.LocalClaim) (err error) {
+	err = api.httpClient.Request(ctx, "PUT", api.httpClient.URI("claim-dialects", LocalDialectID, "claims", id), WithPayload(claim), WithResponse(&models.LocalClaim{}))
+	return
+}
+
+func (api *claimAPI) DeleteLocalClaim(ctx context.Context, id string) (err error) {
+	err = api.httpClient.Request(ctx, "DELETE", api.httpClient.URI("claim-dialects", LocalDialectID, "claims", id))
+	return
+}
+
+func (api *claimAPI) ListExternalClaims(ctx context.Context, dialectID string) (claims []models.ExternalClaim, err error) {
+	err = api.httpClient.Request(ctx, "GET", api.httpClient.URI("claim-dialects", dialectID, "claims"), WithPayload(&claims))
+	return
+}
+
+func (api *claimAPI) CreateExternalClaim(ctx context.Context, dialectID string, claim *models.ExternalClaim) (id string, err error) {
+	var header http.Header
+	err = api.httpClient.Request(ctx, "POST", api.httpClient.URI("claim-dialects", dialectID, "claims"),
+		WithPayload(claim), WithResponseHeader(&header))
+	if err != nil {
+		return "", err
+	}
+	return resourceIDFromLocation(header)
+}
+
+// UpdateExternalClaim replaces the external claim, changing the local claim it is mapped to.
+func (api *claimAPI) UpdateExternalClaim(ctx context.Context, dialectID, id string, claim *models.ExternalClaim) (err error) {
+	err = api.httpClient.Request(ctx, "PUT", api.httpClient.URI("claim-dialects", dialectID, "claims", id), WithPayload(claim), WithResponse(&models.ExternalClaim{}))
+	return
+}
+
+func (api *claimAPI) DeleteExternalClaim(ctx context.Context, dialectID, id string) (err error) {
+	err = api.httpClient.Request(ctx, "DELETE", api.httpClient.URI("claim-dialects", dialectID, "claims", id))
+	return
+}
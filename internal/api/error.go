@@ -11,10 +11,16 @@ type Error struct {
 	Err         string `json:"error"`
 	Message     string `json:"message"`
 	Description string `json:"description"`
+	// Detail is the message of SCIM errors.
+	Detail string `json:"detail"`
 }
 
 func (m *Error) Error() string {
-	return fmt.Sprintf("%d %s: %s", m.StatusCode, m.Err, m.Message)
+	message := m.Message
+	if message == "" {
+		message = m.Detail
+	}
+	return fmt.Sprintf("%d %s: %s", m.StatusCode, m.Err, message)
 }
 
 func (m *Error) Status() int {
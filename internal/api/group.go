@@ -0,0 +1,60 @@
+package api
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/shashimalcse/asgardeo-cli/internal/models"
+)
+
+type groupAPI struct {
+	httpClient HTTPClient
+}
+
+type GroupAPI interface {
+	Paginate(query SCIMQuery, pageSize int) *Paginator[models.Group]
+	Get(ctx context.Context, id string) (group *models.Group, err error)
+	Create(ctx context.Context, group *models.Group) (created *models.Group, err error)
+	Patch(ctx context.Context, id string, operations []models.PatchOperation) (group *models.Group, err error)
+	Delete(ctx context.Context, id string) (err error)
+}
+
+func NewGroupAPI(httpClient HTTPClient) GroupAPI {
+	return &groupAPI{httpClient: httpClient}
+}
+
+// Paginate returns a paginator over the groups matching the query.
+func (api *groupAPI) Paginate(query SCIMQuery, pageSize int) *Paginator[models.Group] {
+	return newPaginator(api.httpClient, api.httpClient.SCIMURI("Groups"), query.params(), pageSize, scimPaging,
+		func(ctx context.Context, uri string, params url.Values) (*Page[models.Group], error) {
+			var list *models.GroupList
+			if err := api.httpClient.Request(ctx, "GET", uri, WithParams(params), WithPayload(&list)); err != nil {
+				return nil, err
+			}
+			if list == nil {
+				return nil, nil
+			}
+			return &Page[models.Group]{Items: list.Resources, TotalResults: list.TotalResults}, nil
+		})
+}
+
+func (api *groupAPI) Get(ctx context.Context, id string) (group *models.Group, err error) {
+	err = api.httpClient.Request(ctx, "GET", api.httpClient.SCIMURI("Groups", id), WithPayload(&group))
+	return
+}
+
+func (api *groupAPI) Create(ctx context.Context, group *models.Group) (created *models.Group, err error) {
+	err = api.httpClient.Request(ctx, "POST", api.httpClient.SCIMURI("Groups"), WithPayload(group), WithResponse(&created))
+	return
+}
+
+func (api *groupAPI) Patch(ctx context.Context, id string, operations []models.PatchOperation) (group *models.Group, err error) {
+	request := &models.PatchRequest{Schemas: []string{models.SCIMPatchOpSchema}, Operations: operations}
+	err = api.httpClient.Request(ctx, "PATCH", api.httpClient.SCIMURI("Groups", id), WithPayload(request), WithResponse(&group))
+	return
+}
+
+func (api *groupAPI) Delete(ctx context.Context, id string) (err error) {
+	err = api.httpClient.Request(ctx, "DELETE", api.httpClient.SCIMURI("Groups", id))
+	return
+}
@@ -8,6 +8,7 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"path"
 	"strings"
 	"time"
 
@@ -19,29 +20,45 @@ type httpClient struct {
 	client   *http.Client
 	baseUrl  *url.URL
 	basepath string
+	scimpath string
 	token    string
 	logger   *zap.Logger
+	retry    RetryPolicy
+	circuit  *circuit
 }
 
 type HTTPClient interface {
 	Request(ctx context.Context, method, uri string, opts ...RequestOption) error
 	Do(req *http.Request) (*http.Response, error)
 	URI(path ...string) string
+	SCIMURI(path ...string) string
 }
 
-func NewHTTPClientAPI(cfg *config.Config, tenantDomain string, logger *zap.Logger) (HTTPClient, error) {
+// NewHTTPClientAPI creates the client of the APIs of the tenant. When an organization ID is given,
+// the APIs of that organization are addressed with the organization access token of the tenant.
+func NewHTTPClientAPI(cfg *config.Config, tenantDomain, organizationID string, retry RetryPolicy, logger *zap.Logger) (HTTPClient, error) {
 	tenant, err := cfg.GetTenant(tenantDomain)
 	if err != nil {
 		logger.Error("failed to get tenant while creating http client", zap.Error(err))
 		return nil, err
 	}
-	basepath := "t/" + tenant.Name + "/api/server/v1"
-	u, err := url.Parse("https://api.asgardeo.io/")
+	basepath := path.Join(config.TenantPath(tenant.Name), "api/server/v1")
+	scimpath := path.Join(config.TenantPath(tenant.Name), "scim2")
+	token := tenant.GetAccessToken()
+	if organizationID != "" {
+		if tenant.Organization == nil || tenant.Organization.ID != organizationID {
+			return nil, fmt.Errorf("tenant %s is not switched to organization %s", tenant.Name, organizationID)
+		}
+		basepath = path.Join(config.OrganizationPath(organizationID), "api/server/v1")
+		scimpath = path.Join(config.OrganizationPath(organizationID), "scim2")
+		token = tenant.GetOrganizationAccessToken()
+	}
+	u, err := url.Parse(tenant.GetServer())
 	if err != nil {
 		logger.Error("failed to parse base URL while creating http client", zap.Error(err))
 		return nil, err
 	}
-	return &httpClient{client: &http.Client{Timeout: 30 * time.Second}, basepath: basepath, baseUrl: u, token: tenant.GetAccessToken(), logger: logger}, nil
+	return &httpClient{client: &http.Client{Timeout: 30 * time.Second}, basepath: basepath, scimpath: scimpath, baseUrl: u, token: token, logger: logger, retry: retry, circuit: &circuit{}}, nil
 }
 
 func (c *httpClient) Request(ctx context.Context, method, uri string, opts ...RequestOption) error {
@@ -49,15 +66,70 @@ func (c *httpClient) Request(ctx context.Context, method, uri string, opts ...Re
 	for _, opt := range opts {
 		opt(options)
 	}
-	request, err := c.newRequest(ctx, method, uri, options.params, options.payload)
-	if err != nil {
-		return fmt.Errorf("failed to create a new request: %w", err)
+	if err := c.circuit.allow(); err != nil {
+		c.logger.Error("request not sent as the circuit is open", zap.String("method", method), zap.String("uri", uri), zap.Error(err))
+		return err
 	}
-	response, err := c.Do(request)
-	if err != nil {
-		c.logger.Error("failed to send the request with http client", zap.String("method", method), zap.String("uri", uri), zap.Error(err))
-		return fmt.Errorf("failed to send the request: %w", err)
+	err := c.requestWithRetries(ctx, method, uri, options)
+	c.circuit.record(err)
+	return err
+}
+
+// requestWithRetries sends the request, retrying network errors and retryable responses with
+// exponential backoff as configured by the retry policy.
+func (c *httpClient) requestWithRetries(ctx context.Context, method, uri string, options *requestOptions) error {
+	for attempt := 1; ; attempt++ {
+		request, err := c.newRequest(ctx, method, uri, options.params, options.payload)
+		if err != nil {
+			return fmt.Errorf("failed to create a new request: %w", err)
+		}
+		canRetry := attempt <= c.retry.MaxRetries
+		response, err := c.Do(request)
+		if err != nil {
+			c.logger.Error("failed to send the request with http client", zap.String("method", method), zap.String("uri", uri), zap.Int("attempt", attempt), zap.Error(err))
+			err = fmt.Errorf("failed to send the request: %w", err)
+			if ctx.Err() != nil || !isIdempotent(method) {
+				return err
+			}
+			if !canRetry {
+				return &RetryError{Attempts: attempt, Err: err}
+			}
+			if err := c.waitBeforeRetry(ctx, method, uri, attempt, c.retry.backoff(attempt-1), err); err != nil {
+				return err
+			}
+			continue
+		}
+		if !isRetryableStatus(method, response.StatusCode) {
+			return c.handleResponse(method, uri, response, options)
+		}
+		c.logger.Error("received an error response from the server", zap.String("method", method), zap.String("uri", uri), zap.Int("attempt", attempt), zap.Int("status_code", response.StatusCode))
+		err = newError(response)
+		_ = response.Body.Close()
+		if !canRetry {
+			return &RetryError{Attempts: attempt, Err: err}
+		}
+		delay := c.retry.backoff(attempt - 1)
+		if after, ok := retryAfter(response.Header); ok {
+			if after > c.retry.MaxDelay {
+				return &RetryError{Attempts: attempt, Err: fmt.Errorf("%w (the server asked to retry after %s)", err, after)}
+			}
+			delay = after
+		}
+		if err := c.waitBeforeRetry(ctx, method, uri, attempt, delay, err); err != nil {
+			return err
+		}
+	}
+}
+
+func (c *httpClient) waitBeforeRetry(ctx context.Context, method, uri string, attempt int, delay time.Duration, cause error) error {
+	c.logger.Warn("retrying the request", zap.String("method", method), zap.String("uri", uri), zap.Int("attempt", attempt), zap.Duration("delay", delay), zap.Error(cause))
+	if err := sleep(ctx, delay); err != nil {
+		return fmt.Errorf("request cancelled while waiting to retry: %w", cause)
 	}
+	return nil
+}
+
+func (c *httpClient) handleResponse(method, uri string, response *http.Response, options *requestOptions) (err error) {
 	defer func() {
 		if cErr := response.Body.Close(); cErr != nil {
 			err = fmt.Errorf("failed to close response body: %w", cErr)
@@ -67,12 +139,19 @@ func (c *httpClient) Request(ctx context.Context, method, uri string, opts ...Re
 		c.logger.Error("received an error response from the server", zap.String("method", method), zap.String("uri", uri), zap.Int("status_code", response.StatusCode))
 		return newError(response)
 	}
+	if options.responseHeader != nil {
+		*options.responseHeader = response.Header
+	}
 	responseBody, err := io.ReadAll(response.Body)
 	if err != nil {
 		return fmt.Errorf("failed to read the response body: %w", err)
 	}
+	target := &options.payload
+	if options.response != nil {
+		target = &options.response
+	}
 	if len(responseBody) > 0 && string(responseBody) != "{}" {
-		if err = json.Unmarshal(responseBody, &options.payload); err != nil {
+		if err = json.Unmarshal(responseBody, target); err != nil {
 			return fmt.Errorf("failed to unmarshal response payload: %w", err)
 		}
 	}
@@ -126,11 +205,21 @@ func (c *httpClient) Do(req *http.Request) (*http.Response, error) {
 	return response, nil
 }
 
+// URI returns the URI of a management API resource.
 func (c *httpClient) URI(path ...string) string {
+	return c.uri(c.basepath, path...)
+}
+
+// SCIMURI returns the URI of a SCIM2 resource.
+func (c *httpClient) SCIMURI(path ...string) string {
+	return c.uri(c.scimpath, path...)
+}
+
+func (c *httpClient) uri(basepath string, path ...string) string {
 	baseURL := &url.URL{
 		Scheme: c.baseUrl.Scheme,
 		Host:   c.baseUrl.Host,
-		Path:   c.basepath + "/",
+		Path:   strings.TrimSuffix(c.baseUrl.Path, "/") + "/" + basepath + "/",
 	}
 	const escapedForwardSlash = "%2F"
 	var escapedPath []string
@@ -147,8 +236,10 @@ func (c *httpClient) URI(path ...string) string {
 type RequestOption func(*requestOptions)
 
 type requestOptions struct {
-	params  url.Values
-	payload interface{}
+	params         url.Values
+	payload        interface{}
+	response       interface{}
+	responseHeader *http.Header
 }
 
 func WithParams(params url.Values) RequestOption {
@@ -162,3 +253,30 @@ func WithPayload(payload interface{}) RequestOption {
 		ro.payload = payload
 	}
 }
+
+// WithResponse decodes the response into a different value than the request payload.
+func WithResponse(response interface{}) RequestOption {
+	return func(ro *requestOptions) {
+		ro.response = response
+	}
+}
+
+// WithResponseHeader captures the headers of a successful response.
+func WithResponseHeader(header *http.Header) RequestOption {
+	return func(ro *requestOptions) {
+		ro.responseHeader = header
+	}
+}
+
+// resourceIDFromLocation extracts the ID of a created resource from the Location header of the response.
+func resourceIDFromLocation(header http.Header) (string, error) {
+	location := header.Get("Location")
+	if location == "" {
+		return "", fmt.Errorf("the response does not contain a Location header")
+	}
+	u, err := url.Parse(location)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse the Location header: %w", err)
+	}
+	return path.Base(u.Path), nil
+}
@@ -0,0 +1,91 @@
+package api
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/shashimalcse/asgardeo-cli/internal/models"
+)
+
+type identityProviderAPI struct {
+	httpClient HTTPClient
+}
+
+type IdentityProviderAPI interface {
+	Paginate(filter string, pageSize int) *Paginator[models.IdentityProvider]
+	Get(ctx context.Context, id string) (idp *models.IdentityProvider, err error)
+	Create(ctx context.Context, idp *models.IdentityProvider) (created *models.IdentityProvider, err error)
+	Patch(ctx context.Context, id string, operations []models.IdentityProviderPatch) (idp *models.IdentityProvider, err error)
+	Delete(ctx context.Context, id string) (err error)
+	GetFederatedAuthenticator(ctx context.Context, id, authenticatorID string) (authenticator *models.FederatedAuthenticator, err error)
+	UpdateFederatedAuthenticator(ctx context.Context, id string, authenticator *models.FederatedAuthenticator) (err error)
+	GetJIT(ctx context.Context, id string) (jit *models.JITProvisioning, err error)
+	UpdateJIT(ctx context.Context, id string, jit *models.JITProvisioning) (err error)
+}
+
+func NewIdentityProviderAPI(httpClient HTTPClient) IdentityProviderAPI {
+	return &identityProviderAPI{httpClient: httpClient}
+}
+
+// Paginate returns a paginator over the identity providers matching the filter, for example
+// 'name sw Google'.
+func (api *identityProviderAPI) Paginate(filter string, pageSize int) *Paginator[models.IdentityProvider] {
+	params := url.Values{}
+	if filter != "" {
+		params.Add("filter", filter)
+	}
+	return newPaginator(api.httpClient, api.httpClient.URI("identity-providers"), params, pageSize, offsetPaging,
+		func(ctx context.Context, uri string, params url.Values) (*Page[models.IdentityProvider], error) {
+			var list *models.IdentityProviderList
+			if err := api.httpClient.Request(ctx, "GET", uri, WithParams(params), WithPayload(&list)); err != nil {
+				return nil, err
+			}
+			if list == nil {
+				return nil, nil
+			}
+			return &Page[models.IdentityProvider]{Items: list.IdentityProviders, Links: list.Links, TotalResults: list.TotalResults}, nil
+		})
+}
+
+func (api *identityProviderAPI) Get(ctx context.Context, id string) (idp *models.IdentityProvider, err error) {
+	err = api.httpClient.Request(ctx, "GET", api.httpClient.URI("identity-providers", id), WithPayload(&idp))
+	return
+}
+
+func (api *identityProviderAPI) Create(ctx context.Context, idp *models.IdentityProvider) (created *models.IdentityProvider, err error) {
+	err = api.httpClient.Request(ctx, "POST", api.httpClient.URI("identity-providers"), WithPayload(idp), WithResponse(&created))
+	return
+}
+
+func (api *identityProviderAPI) Patch(ctx context.Context, id string, operations []models.IdentityProviderPatch) (idp *models.IdentityProvider, err error) {
+	err = api.httpClient.Request(ctx, "PATCH", api.httpClient.URI("identity-providers", id), WithPayload(operations), WithResponse(&idp))
+	return
+}
+
+func (api *identityProviderAPI) Delete(ctx context.Context, id string) (err error) {
+	err = api.httpClient.Request(ctx, "DELETE", api.httpClient.URI("identity-providers", id))
+	return
+}
+
+// GetFederatedAuthenticator returns the federated authenticator with its properties.
+func (api *identityProviderAPI) GetFederatedAuthenticator(ctx context.Context, id, authenticatorID string) (authenticator *models.FederatedAuthenticator, err error) {
+	err = api.httpClient.Request(ctx, "GET", api.httpClient.URI("identity-providers", id, "federated-authenticators", authenticatorID), WithPayload(&authenticator))
+	return
+}
+
+// UpdateFederatedAuthenticator replaces the settings and properties of the federated authenticator.
+func (api *identityProviderAPI) UpdateFederatedAuthenticator(ctx context.Context, id string, authenticator *models.FederatedAuthenticator) (err error) {
+	uri := api.httpClient.URI("identity-providers", id, "federated-authenticators", authenticator.AuthenticatorID)
+	err = api.httpClient.Request(ctx, "PUT", uri, WithPayload(authenticator), WithResponse(&models.FederatedAuthenticator{}))
+	return
+}
+
+func (api *identityProviderAPI) GetJIT(ctx context.Context, id string) (jit *models.JITProvisioning, err error) {
+	err = api.httpClient.Request(ctx, "GET", api.httpClient.URI("identity-providers", id, "provisioning", "jit"), WithPayload(&jit))
+	return
+}
+
+func (api *identityProviderAPI) UpdateJIT(ctx context.Context, id string, jit *models.JITProvisioning) (err error) {
+	err = api.httpClient.Request(ctx, "PUT", api.httpClient.URI("identity-providers", id, "provisioning", "jit"), WithPayload(jit), WithResponse(&models.JITProvisioning{}))
+	return
+}
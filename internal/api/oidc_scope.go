@@ -0,0 +1,50 @@
+package api
+
+import (
+	"context"
+
+	"github.com/shashimalcse/asgardeo-cli/internal/models"
+)
+
+type oidcScopeAPI struct {
+	httpClient HTTPClient
+}
+
+type OIDCScopeAPI interface {
+	List(ctx context.Context) (scopes []models.OIDCScope, err error)
+	Get(ctx context.Context, name string) (scope *models.OIDCScope, err error)
+	Create(ctx context.Context, scope *models.OIDCScope) (err error)
+	Update(ctx context.Context, name string, scope *models.OIDCScope) (err error)
+	Delete(ctx context.Context, name string) (err error)
+}
+
+func NewOIDCScopeAPI(httpClient HTTPClient) OIDCScopeAPI {
+	return &oidcScopeAPI{httpClient: httpClient}
+}
+
+func (api *oidcScopeAPI) List(ctx context.Context) (scopes []models.OIDCScope, err error) {
+	err = api.httpClient.Request(ctx, "GET", api.httpClient.URI("oidc", "scopes"), WithPayload(&scopes))
+	return
+}
+
+func (api *oidcScopeAPI) Get(ctx context.Context, name string) (scope *models.OIDCScope, err error) {
+	err = api.httpClient.Request(ctx, "GET", api.httpClient.URI("oidc", "scopes", name), WithPayload(&scope))
+	return
+}
+
+func (api *oidcScopeAPI) Create(ctx context.Context, scope *models.OIDCScope) (err error) {
+	err = api.httpClient.Request(ctx, "POST", api.httpClient.URI("oidc", "scopes"), WithPayload(scope), WithResponse(&models.OIDCScope{}))
+	return
+}
+
+// Update replaces the display name, description and claims of the scope. The name of a scope
+// cannot be changed.
+func (api *oidcScopeAPI) Update(ctx context.Context, name string, scope *models.OIDCScope) (err error) {
+	err = api.httpClient.Request(ctx, "PUT", api.httpClient.URI("oidc", "scopes", name), WithPayload(scope), WithResponse(&models.OIDCScope{}))
+	return
+}
+
+func (api *oidcScopeAPI) Delete(ctx context.Context, name string) (err error) {
+	err = api.httpClient.Request(ctx, "DELETE", api.httpClient.URI("oidc", "scopes", name))
+	return
+}
@@ -0,0 +1,98 @@
+package api
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/shashimalcse/asgardeo-cli/internal/models"
+)
+
+type organizationAPI struct {
+	httpClient HTTPClient
+}
+
+type OrganizationAPI interface {
+	List(ctx context.Context, filter string) (organizations []models.Organization, err error)
+	Paginate(filter string, pageSize int) *Paginator[models.Organization]
+	Get(ctx context.Context, id string) (organization *models.Organization, err error)
+	Create(ctx context.Context, organization *models.OrganizationCreate) (created *models.Organization, err error)
+	Delete(ctx context.Context, id string) (err error)
+	ShareApplication(ctx context.Context, id, applicationID string, share *models.ApplicationShare) (err error)
+	ListSharedOrganizations(ctx context.Context, id, applicationID string) (organizations []models.Organization, err error)
+	UnshareApplication(ctx context.Context, id, applicationID, sharedOrganizationID string) (err error)
+	UnshareApplicationWithAll(ctx context.Context, id, applicationID string) (err error)
+}
+
+func NewOrganizationAPI(httpClient HTTPClient) OrganizationAPI {
+	return &organizationAPI{httpClient: httpClient}
+}
+
+// List returns every organization matching the filter, fetching all the pages.
+func (api *organizationAPI) List(ctx context.Context, filter string) (organizations []models.Organization, err error) {
+	return api.Paginate(filter, DefaultPageSize).All(ctx, 0)
+}
+
+// Paginate returns a paginator over the organizations matching the filter, with the organizations
+// of every level below the organization of the client. The endpoint is cursor based, so the pages
+// are followed through their next links.
+func (api *organizationAPI) Paginate(filter string, pageSize int) *Paginator[models.Organization] {
+	params := url.Values{}
+	params.Add("recursive", "true")
+	if filter != "" {
+		params.Add("filter", filter)
+	}
+	return newPaginator(api.httpClient, api.httpClient.URI("organizations"), params, pageSize, cursorPaging,
+		func(ctx context.Context, uri string, params url.Values) (*Page[models.Organization], error) {
+			var list *models.OrganizationList
+			if err := api.httpClient.Request(ctx, "GET", uri, WithParams(params), WithPayload(&list)); err != nil {
+				return nil, err
+			}
+			if list == nil {
+				return nil, nil
+			}
+			return &Page[models.Organization]{Items: list.Organizations, Links: list.Links}, nil
+		})
+}
+
+func (api *organizationAPI) Get(ctx context.Context, id string) (organization *models.Organization, err error) {
+	err = api.httpClient.Request(ctx, "GET", api.httpClient.URI("organizations", id), WithPayload(&organization))
+	return
+}
+
+func (api *organizationAPI) Create(ctx context.Context, organization *models.OrganizationCreate) (created *models.Organization, err error) {
+	err = api.httpClient.Request(ctx, "POST", api.httpClient.URI("organizations"), WithPayload(organization), WithResponse(&created))
+	return
+}
+
+func (api *organizationAPI) Delete(ctx context.Context, id string) (err error) {
+	err = api.httpClient.Request(ctx, "DELETE", api.httpClient.URI("organizations", id))
+	return
+}
+
+// ShareApplication shares the application of the organization with its child organizations.
+func (api *organizationAPI) ShareApplication(ctx context.Context, id, applicationID string, share *models.ApplicationShare) (err error) {
+	err = api.httpClient.Request(ctx, "POST", api.httpClient.URI("organizations", id, "applications", applicationID, "share"), WithPayload(share))
+	return
+}
+
+// ListSharedOrganizations returns the organizations the application of the organization is shared with.
+func (api *organizationAPI) ListSharedOrganizations(ctx context.Context, id, applicationID string) (organizations []models.Organization, err error) {
+	var list *models.OrganizationList
+	err = api.httpClient.Request(ctx, "GET", api.httpClient.URI("organizations", id, "applications", applicationID, "shared-organizations"), WithPayload(&list))
+	if err != nil || list == nil {
+		return nil, err
+	}
+	return list.Organizations, nil
+}
+
+// UnshareApplication stops sharing the application of the organization with the shared organization.
+func (api *organizationAPI) UnshareApplication(ctx context.Context, id, applicationID, sharedOrganizationID string) (err error) {
+	err = api.httpClient.Request(ctx, "DELETE", api.httpClient.URI("organizations", id, "applications", applicationID, "share", sharedOrganizationID))
+	return
+}
+
+// UnshareApplicationWithAll stops sharing the application of the organization with every organization.
+func (api *organizationAPI) UnshareApplicationWithAll(ctx context.Context, id, applicationID string) (err error) {
+	err = api.httpClient.Request(ctx, "DELETE", api.httpClient.URI("organizations", id, "applications", applicationID, "share"))
+	return
+}
@@ -0,0 +1,174 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/shashimalcse/asgardeo-cli/internal/models"
+)
+
+// DefaultPageSize is the number of items requested per page when no page size is given.
+const DefaultPageSize = 50
+
+// Page is a single page of a list endpoint.
+type Page[T any] struct {
+	Items        []T
+	Links        []models.Link
+	TotalResults int
+}
+
+// pageFetcher fetches the page at the URI, adding the query parameters.
+type pageFetcher[T any] func(ctx context.Context, uri string, params url.Values) (*Page[T], error)
+
+// paging sets the query parameters selecting a page, given the number of items already fetched.
+// Paging without offsets only follows the next links of the pages.
+type paging struct {
+	setParams func(params url.Values, offset, pageSize int)
+	offsets   bool
+}
+
+var (
+	// offsetPaging pages with the limit and offset parameters of the management APIs.
+	offsetPaging = paging{offsets: true, setParams: func(params url.Values, offset, pageSize int) {
+		params.Set("limit", strconv.Itoa(pageSize))
+		if offset > 0 {
+			params.Set("offset", strconv.Itoa(offset))
+		}
+	}}
+	// cursorPaging pages with the limit parameter and the cursors in the next links.
+	cursorPaging = paging{setParams: func(params url.Values, offset, pageSize int) {
+		params.Set("limit", strconv.Itoa(pageSize))
+	}}
+	// scimPaging pages with the one based startIndex and count parameters of SCIM.
+	scimPaging = paging{offsets: true, setParams: func(params url.Values, offset, pageSize int) {
+		params.Set("startIndex", strconv.Itoa(offset+1))
+		params.Set("count", strconv.Itoa(pageSize))
+	}}
+)
+
+// Paginator iterates over the pages of a list endpoint. It follows the next link of each page and,
+// for endpoints that support offsets, falls back to offset paging when a page has no links.
+type Paginator[T any] struct {
+	httpClient HTTPClient
+	fetch      pageFetcher[T]
+	uri        string
+	params     url.Values
+	pageSize   int
+	paging     paging
+	nextURI    string
+	fetched    int
+	total      int
+	done       bool
+}
+
+func newPaginator[T any](httpClient HTTPClient, uri string, params url.Values, pageSize int, paging paging, fetch pageFetcher[T]) *Paginator[T] {
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+	if params == nil {
+		params = url.Values{}
+	}
+	return &Paginator[T]{httpClient: httpClient, fetch: fetch, uri: uri, params: params, pageSize: pageSize, paging: paging, total: -1}
+}
+
+// HasNext reports whether there are pages left to fetch.
+func (p *Paginator[T]) HasNext() bool {
+	return !p.done
+}
+
+// TotalResults returns the total number of items reported by the server, or -1 before the first page.
+func (p *Paginator[T]) TotalResults() int {
+	return p.total
+}
+
+// Next fetches the next page. It returns no items once every page is fetched.
+func (p *Paginator[T]) Next(ctx context.Context) ([]T, error) {
+	if p.done {
+		return nil, nil
+	}
+	uri, params := p.uri, cloneValues(p.params)
+	if p.nextURI != "" {
+		uri, params = p.nextURI, nil
+	} else {
+		p.paging.setParams(params, p.fetched, p.pageSize)
+	}
+	page, err := p.fetch(ctx, uri, params)
+	if err != nil {
+		return nil, err
+	}
+	if page == nil {
+		p.done = true
+		return nil, nil
+	}
+	p.fetched += len(page.Items)
+	if page.TotalResults > 0 || p.total < 0 {
+		p.total = page.TotalResults
+	}
+	p.nextURI = ""
+	for _, link := range page.Links {
+		if link.Rel == "next" && link.Href != "" {
+			next, err := p.resolve(link.Href)
+			if err != nil {
+				return nil, err
+			}
+			p.nextURI = next
+		}
+	}
+	switch {
+	case len(page.Items) == 0:
+		p.done = true
+	case p.nextURI != "":
+	case p.paging.offsets && p.total > p.fetched && len(page.Links) == 0:
+	default:
+		p.done = true
+	}
+	return page.Items, nil
+}
+
+// All fetches pages until limit items are collected, or every item when limit is zero.
+func (p *Paginator[T]) All(ctx context.Context, limit int) ([]T, error) {
+	var items []T
+	for p.HasNext() && (limit <= 0 || len(items) < limit) {
+		page, err := p.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, page...)
+	}
+	if limit > 0 && len(items) > limit {
+		items = items[:limit]
+	}
+	return items, nil
+}
+
+// resolve turns the href of a link into a URI, keeping the query parameters of the first request
+// that the link leaves out.
+func (p *Paginator[T]) resolve(href string) (string, error) {
+	base, err := url.Parse(p.httpClient.URI())
+	if err != nil {
+		return "", fmt.Errorf("failed to parse the base URI: %w", err)
+	}
+	ref, err := url.Parse(href)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse the next link %q: %w", href, err)
+	}
+	next := base.ResolveReference(ref)
+	query := next.Query()
+	for key, values := range p.params {
+		if !query.Has(key) {
+			query[key] = values
+		}
+	}
+	next.RawQuery = query.Encode()
+	return next.String(), nil
+}
+
+func cloneValues(values url.Values) url.Values {
+	clone := url.Values{}
+	for key, value := range values {
+		clone[key] = append([]string(nil), value...)
+	}
+	return clone
+}
@@ -0,0 +1,149 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// RetryPolicy configures how failed requests are retried.
+type RetryPolicy struct {
+	// MaxRetries is the number of retries after the first attempt. Zero disables retries.
+	MaxRetries int
+	// BaseDelay is the delay before the first retry, doubled on every following retry.
+	BaseDelay time.Duration
+	// MaxDelay caps the delay between attempts, including the delay asked by a Retry-After header.
+	MaxDelay time.Duration
+}
+
+// DefaultRetryPolicy returns the retry policy used when none is configured.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{MaxRetries: 3, BaseDelay: 500 * time.Millisecond, MaxDelay: 30 * time.Second}
+}
+
+// backoff returns the delay before the given retry, starting from zero. The delay grows
+// exponentially and is randomised between half and the full delay so that clients spread out.
+func (p RetryPolicy) backoff(retry int) time.Duration {
+	delay := p.BaseDelay << retry
+	if delay <= 0 || delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+	if delay <= 0 {
+		return 0
+	}
+	half := delay / 2
+	return half + rand.N(half+1)
+}
+
+// isIdempotent reports whether a request can be sent again without changing its outcome.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// isRetryableStatus reports whether a response status is worth retrying. Rate limited requests
+// are not processed by the server, so they are retried for every method, while server errors
+// are only retried for idempotent methods.
+func isRetryableStatus(method string, status int) bool {
+	switch status {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return isIdempotent(method)
+	}
+	return false
+}
+
+// retryAfter parses the Retry-After header, given either in seconds or as an HTTP date.
+func retryAfter(header http.Header) (time.Duration, bool) {
+	value := header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), true
+	}
+	return 0, false
+}
+
+// sleep waits for the delay or until the context is done.
+func sleep(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// RetryError is returned when a request still fails after all its attempts.
+type RetryError struct {
+	Attempts int
+	Err      error
+}
+
+func (e *RetryError) Error() string {
+	if e.Attempts == 1 {
+		return e.Err.Error()
+	}
+	return fmt.Sprintf("request failed after %d attempts: %s", e.Attempts, e.Err)
+}
+
+func (e *RetryError) Unwrap() error {
+	return e.Err
+}
+
+const (
+	circuitThreshold = 3
+	circuitCooldown  = 30 * time.Second
+)
+
+// ErrCircuitOpen is returned without sending the request while the server keeps failing.
+var ErrCircuitOpen = errors.New("the server is failing repeatedly, requests are paused")
+
+// circuit stops sending requests after consecutive requests exhausted their retries, so that long
+// running commands fail fast with the cause instead of retrying every remaining request.
+type circuit struct {
+	mu       sync.Mutex
+	failures int
+	openedAt time.Time
+	lastErr  error
+}
+
+// allow returns an error while the circuit is open. After the cooldown, requests are sent again.
+func (c *circuit) allow() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.failures < circuitThreshold || time.Since(c.openedAt) >= circuitCooldown {
+		return nil
+	}
+	return fmt.Errorf("%w, last error: %w", ErrCircuitOpen, c.lastErr)
+}
+
+func (c *circuit) record(err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var retryErr *RetryError
+	if !errors.As(err, &retryErr) {
+		c.failures = 0
+		return
+	}
+	c.failures++
+	c.lastErr = retryErr.Err
+	if c.failures >= circuitThreshold {
+		c.openedAt = time.Now()
+	}
+}
@@ -0,0 +1,129 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRetryAfter(t *testing.T) {
+	tests := []struct {
+		name   string
+		value  string
+		want   time.Duration
+		wantOK bool
+	}{
+		{name: "missing", value: "", wantOK: false},
+		{name: "seconds", value: "120", want: 2 * time.Minute, wantOK: true},
+		{name: "zero seconds", value: "0", want: 0, wantOK: true},
+		{name: "negative seconds", value: "-5", wantOK: false},
+		{name: "date in the past", value: "Wed, 21 Oct 2015 07:28:00 GMT", want: 0, wantOK: true},
+		{name: "invalid", value: "soon", wantOK: false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			header := http.Header{}
+			if test.value != "" {
+				header.Set("Retry-After", test.value)
+			}
+			got, ok := retryAfter(header)
+			if ok != test.wantOK || got != test.want {
+				t.Errorf("retryAfter(%q) = %s, %t, want %s, %t", test.value, got, ok, test.want, test.wantOK)
+			}
+		})
+	}
+}
+
+func TestRetryAfterFutureDate(t *testing.T) {
+	header := http.Header{}
+	header.Set("Retry-After", time.Now().Add(time.Minute).UTC().Format(http.TimeFormat))
+	got, ok := retryAfter(header)
+	if !ok || got <= 50*time.Second || got > time.Minute {
+		t.Errorf("retryAfter() = %s, %t, want about a minute", got, ok)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	policy := RetryPolicy{MaxRetries: 5, BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second}
+	tests := []struct {
+		retry int
+		full  time.Duration
+	}{
+		{retry: 0, full: 100 * time.Millisecond},
+		{retry: 1, full: 200 * time.Millisecond},
+		{retry: 2, full: 400 * time.Millisecond},
+		{retry: 3, full: 800 * time.Millisecond},
+		// The delay is capped by the maximum delay, also when the shift overflows.
+		{retry: 4, full: time.Second},
+		{retry: 70, full: time.Second},
+	}
+	for _, test := range tests {
+		for i := 0; i < 50; i++ {
+			got := policy.backoff(test.retry)
+			if got < test.full/2 || got > test.full {
+				t.Fatalf("backoff(%d) = %s, want between %s and %s", test.retry, got, test.full/2, test.full)
+			}
+		}
+	}
+	if got := (RetryPolicy{}).backoff(0); got != 0 {
+		t.Errorf("backoff without delays = %s, want 0", got)
+	}
+}
+
+func TestIsRetryableStatus(t *testing.T) {
+	tests := []struct {
+		method string
+		status int
+		want   bool
+	}{
+		{method: http.MethodPost, status: http.StatusTooManyRequests, want: true},
+		{method: http.MethodGet, status: http.StatusServiceUnavailable, want: true},
+		{method: http.MethodPut, status: http.StatusBadGateway, want: true},
+		{method: http.MethodPost, status: http.StatusServiceUnavailable, want: false},
+		{method: http.MethodPatch, status: http.StatusInternalServerError, want: false},
+		{method: http.MethodGet, status: http.StatusNotFound, want: false},
+		{method: http.MethodGet, status: http.StatusNotImplemented, want: false},
+	}
+	for _, test := range tests {
+		if got := isRetryableStatus(test.method, test.status); got != test.want {
+			t.Errorf("isRetryableStatus(%s, %d) = %t, want %t", test.method, test.status, got, test.want)
+		}
+	}
+}
+
+func TestCircuit(t *testing.T) {
+	exhausted := &RetryError{Attempts: 4, Err: errors.New("503 Service Unavailable")}
+	c := &circuit{}
+
+	for i := 0; i < circuitThreshold-1; i++ {
+		c.record(exhausted)
+		if err := c.allow(); err != nil {
+			t.Fatalf("allow() after %d failures = %v, want nil", i+1, err)
+		}
+	}
+	// A request that does not exhaust its retries resets the failures.
+	c.record(errors.New("404 Not Found"))
+	c.record(nil)
+	for i := 0; i < circuitThreshold-1; i++ {
+		c.record(exhausted)
+	}
+	if err := c.allow(); err != nil {
+		t.Fatalf("allow() after the failures were reset = %v, want nil", err)
+	}
+
+	c.record(exhausted)
+	err := c.allow()
+	if !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("allow() after %d consecutive failures = %v, want ErrCircuitOpen", circuitThreshold, err)
+	}
+	if !errors.Is(err, exhausted.Err) {
+		t.Errorf("allow() = %v, want it to wrap the last error", err)
+	}
+
+	// Once the cooldown is over, requests are sent again.
+	c.openedAt = time.Now().Add(-circuitCooldown)
+	if err := c.allow(); err != nil {
+		t.Errorf("allow() after the cooldown = %v, want nil", err)
+	}
+}
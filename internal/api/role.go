@@ -0,0 +1,60 @@
+package api
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/shashimalcse/asgardeo-cli/internal/models"
+)
+
+type roleAPI struct {
+	httpClient HTTPClient
+}
+
+type RoleAPI interface {
+	Paginate(query SCIMQuery, pageSize int) *Paginator[models.RoleV2]
+	Get(ctx context.Context, id string) (role *models.RoleV2, err error)
+	Create(ctx context.Context, role *models.RoleV2) (created *models.RoleV2, err error)
+	Patch(ctx context.Context, id string, operations []models.PatchOperation) (role *models.RoleV2, err error)
+	Delete(ctx context.Context, id string) (err error)
+}
+
+func NewRoleAPI(httpClient HTTPClient) RoleAPI {
+	return &roleAPI{httpClient: httpClient}
+}
+
+// Paginate returns a paginator over the roles matching the query, listed with the v2 roles API.
+func (api *roleAPI) Paginate(query SCIMQuery, pageSize int) *Paginator[models.RoleV2] {
+	return newPaginator(api.httpClient, api.httpClient.SCIMURI("v2", "Roles"), query.params(), pageSize, scimPaging,
+		func(ctx context.Context, uri string, params url.Values) (*Page[models.RoleV2], error) {
+			var list *models.RoleV2List
+			if err := api.httpClient.Request(ctx, "GET", uri, WithParams(params), WithPayload(&list)); err != nil {
+				return nil, err
+			}
+			if list == nil {
+				return nil, nil
+			}
+			return &Page[models.RoleV2]{Items: list.Resources, TotalResults: list.TotalResults}, nil
+		})
+}
+
+func (api *roleAPI) Get(ctx context.Context, id string) (role *models.RoleV2, err error) {
+	err = api.httpClient.Request(ctx, "GET", api.httpClient.SCIMURI("v2", "Roles", id), WithPayload(&role))
+	return
+}
+
+func (api *roleAPI) Create(ctx context.Context, role *models.RoleV2) (created *models.RoleV2, err error) {
+	err = api.httpClient.Request(ctx, "POST", api.httpClient.SCIMURI("v2", "Roles"), WithPayload(role), WithResponse(&created))
+	return
+}
+
+func (api *roleAPI) Patch(ctx context.Context, id string, operations []models.PatchOperation) (role *models.RoleV2, err error) {
+	request := &models.PatchRequest{Schemas: []string{models.SCIMPatchOpSchema}, Operations: operations}
+	err = api.httpClient.Request(ctx, "PATCH", api.httpClient.SCIMURI("v2", "Roles", id), WithPayload(request), WithResponse(&role))
+	return
+}
+
+func (api *roleAPI) Delete(ctx context.Context, id string) (err error) {
+	err = api.httpClient.Request(ctx, "DELETE", api.httpClient.SCIMURI("v2", "Roles", id))
+	return
+}
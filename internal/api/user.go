@@ -0,0 +1,112 @@
+package api
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/shashimalcse/asgardeo-cli/internal/models"
+)
+
+// SCIMQuery selects and shapes the resources returned by a SCIM list or search.
+type SCIMQuery struct {
+	Filter             string
+	Attributes         []string
+	ExcludedAttributes []string
+}
+
+func (q SCIMQuery) params() url.Values {
+	params := url.Values{}
+	if q.Filter != "" {
+		params.Set("filter", q.Filter)
+	}
+	if len(q.Attributes) > 0 {
+		params.Set("attributes", strings.Join(q.Attributes, ","))
+	}
+	if len(q.ExcludedAttributes) > 0 {
+		params.Set("excludedAttributes", strings.Join(q.ExcludedAttributes, ","))
+	}
+	return params
+}
+
+type userAPI struct {
+	httpClient HTTPClient
+}
+
+type UserAPI interface {
+	Paginate(query SCIMQuery, pageSize int) *Paginator[models.User]
+	Search(query SCIMQuery, pageSize int) *Paginator[models.User]
+	Get(ctx context.Context, id string, attributes []string) (user *models.User, err error)
+	Create(ctx context.Context, user *models.User) (created *models.User, err error)
+	Patch(ctx context.Context, id string, operations []models.PatchOperation) (user *models.User, err error)
+	Delete(ctx context.Context, id string) (err error)
+}
+
+func NewUserAPI(httpClient HTTPClient) UserAPI {
+	return &userAPI{httpClient: httpClient}
+}
+
+// Paginate returns a paginator over the users matching the query, listed with GET requests.
+func (api *userAPI) Paginate(query SCIMQuery, pageSize int) *Paginator[models.User] {
+	return newPaginator(api.httpClient, api.httpClient.SCIMURI("Users"), query.params(), pageSize, scimPaging,
+		func(ctx context.Context, uri string, params url.Values) (*Page[models.User], error) {
+			var list *models.UserList
+			if err := api.httpClient.Request(ctx, "GET", uri, WithParams(params), WithPayload(&list)); err != nil {
+				return nil, err
+			}
+			return userPage(list), nil
+		})
+}
+
+// Search returns a paginator over the users matching the query, searched with POST requests so
+// that long filters are not limited by the length of the URL.
+func (api *userAPI) Search(query SCIMQuery, pageSize int) *Paginator[models.User] {
+	return newPaginator(api.httpClient, api.httpClient.SCIMURI("Users", ".search"), nil, pageSize, scimPaging,
+		func(ctx context.Context, uri string, params url.Values) (*Page[models.User], error) {
+			startIndex, _ := strconv.Atoi(params.Get("startIndex"))
+			count, _ := strconv.Atoi(params.Get("count"))
+			request := models.SearchRequest{
+				Schemas:            []string{models.SCIMSearchRequestSchema},
+				Filter:             query.Filter,
+				Attributes:         query.Attributes,
+				ExcludedAttributes: query.ExcludedAttributes,
+				StartIndex:         startIndex,
+				Count:              count,
+			}
+			var list *models.UserList
+			if err := api.httpClient.Request(ctx, "POST", uri, WithPayload(&request), WithResponse(&list)); err != nil {
+				return nil, err
+			}
+			return userPage(list), nil
+		})
+}
+
+func userPage(list *models.UserList) *Page[models.User] {
+	if list == nil {
+		return nil
+	}
+	return &Page[models.User]{Items: list.Resources, TotalResults: list.TotalResults}
+}
+
+func (api *userAPI) Get(ctx context.Context, id string, attributes []string) (user *models.User, err error) {
+	params := SCIMQuery{Attributes: attributes}.params()
+	err = api.httpClient.Request(ctx, "GET", api.httpClient.SCIMURI("Users", id), WithParams(params), WithPayload(&user))
+	return
+}
+
+func (api *userAPI) Create(ctx context.Context, user *models.User) (created *models.User, err error) {
+	err = api.httpClient.Request(ctx, "POST", api.httpClient.SCIMURI("Users"), WithPayload(user), WithResponse(&created))
+	return
+}
+
+func (api *userAPI) Patch(ctx context.Context, id string, operations []models.PatchOperation) (user *models.User, err error) {
+	request := &models.PatchRequest{Schemas: []string{models.SCIMPatchOpSchema}, Operations: operations}
+	err = api.httpClient.Request(ctx, "PATCH", api.httpClient.SCIMURI("Users", id), WithPayload(request), WithResponse(&user))
+	return
+}
+
+func (api *userAPI) Delete(ctx context.Context, id string) (err error) {
+	err = api.httpClient.Request(ctx, "DELETE", api.httpClient.SCIMURI("Users", id))
+	return
+}
@@ -8,6 +8,8 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+
+	"github.com/shashimalcse/asgardeo-cli/internal/config"
 )
 
 var (
@@ -25,6 +27,26 @@ type ClientCredentials struct {
 	ClientID     string
 	ClientSecret string
 	Tenant       string
+	Server       string
+}
+
+type RefreshTokenCredentials struct {
+	ClientID     string
+	ClientSecret string
+	RefreshToken string
+	Tenant       string
+	Server       string
+}
+
+// OrganizationSwitchCredentials exchanges the access token of a tenant for an access token of one
+// of its organizations.
+type OrganizationSwitchCredentials struct {
+	ClientID     string
+	ClientSecret string
+	AccessToken  string
+	Organization string
+	Tenant       string
+	Server       string
 }
 
 type State struct {
@@ -39,11 +61,13 @@ type State struct {
 type Credentials struct {
 	ClientID string
 	Tenant   string
+	Server   string
 }
 
 var credentials = &Credentials{
 	ClientID: "",
 	Tenant:   "",
+	Server:   config.DefaultServer,
 }
 
 func GetDeviceCode(httpClient *http.Client) (State, error) {
@@ -58,7 +82,7 @@ func GetDeviceCode(httpClient *http.Client) (State, error) {
 		"client_id": {a.ClientID},
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("https://api.asgardeo.io/t/%s/oauth2/device_authorize", a.Tenant), strings.NewReader(data.Encode()))
+	req, err := http.NewRequest("POST", deviceAuthorizeEndpoint(a.Server, a.Tenant), strings.NewReader(data.Encode()))
 	if err != nil {
 		return State{}, err
 	}
@@ -99,7 +123,7 @@ func GetAccessTokenFromDeviceCode(httpClient *http.Client, state State) (Result,
 		"scope":       {"SYSTEM"},
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("https://api.asgardeo.io/t/%s/oauth2/token", credentials.Tenant), strings.NewReader(data.Encode()))
+	req, err := http.NewRequest("POST", tokenEndpoint(credentials.Server, credentials.Tenant), strings.NewReader(data.Encode()))
 	if err != nil {
 		return Result{}, err
 	}
@@ -132,7 +156,7 @@ func AuthenticateWithClientCredentials(httpClient *http.Client, args ClientCrede
 		"grant_type": {"client_credentials"},
 		"scope":      {SystemScope},
 	}
-	req, err := http.NewRequest("POST", fmt.Sprintf("https://api.asgardeo.io/t/%s/oauth2/token", args.Tenant), strings.NewReader(data.Encode()))
+	req, err := http.NewRequest("POST", tokenEndpoint(args.Server, args.Tenant), strings.NewReader(data.Encode()))
 	if err != nil {
 		return Result{}, err
 	}
@@ -164,7 +188,127 @@ func AuthenticateWithClientCredentials(httpClient *http.Client, args ClientCrede
 	return result, nil
 }
 
+func AuthenticateWithRefreshToken(httpClient *http.Client, args RefreshTokenCredentials) (Result, error) {
+
+	data := url.Values{
+		"grant_type":    {"refresh_token"},
+		"refresh_token": {args.RefreshToken},
+		"scope":         {SystemScope},
+	}
+	if args.ClientSecret == "" {
+		data.Set("client_id", args.ClientID)
+	}
+	req, err := http.NewRequest("POST", tokenEndpoint(args.Server, args.Tenant), strings.NewReader(data.Encode()))
+	if err != nil {
+		return Result{}, err
+	}
+	req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+	if args.ClientSecret != "" {
+		req.Header.Add("Authorization", "Basic "+getBasicAuth(args.ClientID, args.ClientSecret))
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return Result{}, err
+	}
+	defer func() {
+		if cErr := resp.Body.Close(); cErr != nil {
+			err = fmt.Errorf("failed to close response body: %w", cErr)
+		}
+	}()
+	if resp.StatusCode != http.StatusOK {
+		if resp.StatusCode == http.StatusBadRequest || resp.StatusCode == http.StatusUnauthorized {
+			return Result{}, fmt.Errorf("failed to refresh the access token. the refresh token is invalid or expired")
+		}
+		return Result{}, fmt.Errorf("failed to refresh the access token: %s", resp.Status)
+	}
+	var result Result
+	err = json.NewDecoder(resp.Body).Decode(&result)
+	if err != nil {
+		return Result{}, err
+	}
+	return result, nil
+}
+
+// SwitchOrganization exchanges the access token of the tenant for an access token of the
+// organization with the organization_switch grant.
+func SwitchOrganization(httpClient *http.Client, args OrganizationSwitchCredentials) (Result, error) {
+
+	data := url.Values{
+		"grant_type":             {"organization_switch"},
+		"token":                  {args.AccessToken},
+		"switching_organization": {args.Organization},
+		"scope":                  {SystemScope},
+	}
+	if args.ClientSecret == "" {
+		data.Set("client_id", args.ClientID)
+	}
+	req, err := http.NewRequest("POST", tokenEndpoint(args.Server, args.Tenant), strings.NewReader(data.Encode()))
+	if err != nil {
+		return Result{}, err
+	}
+	req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+	if args.ClientSecret != "" {
+		req.Header.Add("Authorization", "Basic "+getBasicAuth(args.ClientID, args.ClientSecret))
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return Result{}, err
+	}
+	defer func() {
+		if cErr := resp.Body.Close(); cErr != nil {
+			err = fmt.Errorf("failed to close response body: %w", cErr)
+		}
+	}()
+	if resp.StatusCode != http.StatusOK {
+		if resp.StatusCode == http.StatusBadRequest || resp.StatusCode == http.StatusUnauthorized {
+			return Result{}, fmt.Errorf("failed to switch to the organization: %s. check that the organization exists and that the application is shared with it", errorDescription(resp))
+		}
+		return Result{}, fmt.Errorf("failed to switch to the organization: %s", errorDescription(resp))
+	}
+	var result Result
+	err = json.NewDecoder(resp.Body).Decode(&result)
+	if err != nil {
+		return Result{}, err
+	}
+	return result, nil
+}
+
+// errorDescription returns the description of the OAuth2 error response, falling back to the error
+// code and then to the status when the body is not an OAuth2 error.
+func errorDescription(resp *http.Response) string {
+	var oauthErr struct {
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&oauthErr); err == nil {
+		if oauthErr.ErrorDescription != "" {
+			return oauthErr.ErrorDescription
+		}
+		if oauthErr.Error != "" {
+			return oauthErr.Error
+		}
+	}
+	return resp.Status
+}
+
 func getBasicAuth(clientID, clientSecret string) string {
 	auth := clientID + ":" + clientSecret
 	return base64.StdEncoding.EncodeToString([]byte(auth))
 }
+
+// tokenEndpoint returns the OAuth2 token endpoint of the tenant on the given server.
+func tokenEndpoint(server, tenant string) string {
+	return oauth2Endpoint(server, tenant, "token")
+}
+
+// deviceAuthorizeEndpoint returns the device authorization endpoint of the tenant on the given server.
+func deviceAuthorizeEndpoint(server, tenant string) string {
+	return oauth2Endpoint(server, tenant, "device_authorize")
+}
+
+func oauth2Endpoint(server, tenant, endpoint string) string {
+	if server == "" {
+		server = config.DefaultServer
+	}
+	return config.TenantBaseURL(server, tenant) + "/oauth2/" + endpoint
+}
@@ -4,10 +4,13 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strconv"
+	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/shashimalcse/asgardeo-cli/internal/core"
 	interactive "github.com/shashimalcse/asgardeo-cli/internal/interactive/api_resource"
+	"github.com/shashimalcse/asgardeo-cli/internal/models"
 	"github.com/spf13/cobra"
 )
 
@@ -18,30 +21,124 @@ func apiResourceCmd(cli *core.CLI) *cobra.Command {
 	}
 
 	cmd.AddCommand(listApiResourceCmd(cli))
+	cmd.AddCommand(getAPIResourceCmd(cli))
 	cmd.AddCommand(createAPIResourceCmd(cli))
+	cmd.AddCommand(updateAPIResourceCmd(cli))
 	cmd.AddCommand(deleteAPIResourceCmd(cli))
+	cmd.AddCommand(apiScopesCmd(cli))
 	return cmd
 }
 
 func listApiResourceCmd(cli *core.CLI) *cobra.Command {
+	var output OutputInputs
+	var page PageInputs
 	cmd := &cobra.Command{
 		Use:     "list",
 		Aliases: []string{"ls"},
 		Args:    cobra.NoArgs,
 		Short:   "List your api resources",
 		Example: `asgardeo apis list
-				  asgardeo apis ls`,
+  asgardeo apis ls
+  asgardeo apis list --all --output yaml
+  asgardeo apis list --go-template '{{range .apiResources}}{{.identifier}}{{"\n"}}{{end}}'`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			m := interactive.NewApiResourceListModel(cli)
-			p := tea.NewProgram(m, tea.WithAltScreen())
-			if _, err := p.Run(); err != nil {
-				fmt.Println("Error running program:", err)
-				os.Exit(1)
+			if err := output.validate(); err != nil {
+				return err
+			}
+			if err := page.validate(); err != nil {
+				return err
+			}
+			paginator := cli.API.APIResource.Paginate("BUSINESS", page.PageSize)
+			if output.IsInteractive() {
+				m := interactive.NewApiResourceListModel(cli, paginator, page.limit())
+				p := tea.NewProgram(m, tea.WithAltScreen())
+				if _, err := p.Run(); err != nil {
+					fmt.Println("Error running program:", err)
+					os.Exit(1)
+				}
+				return nil
+			}
+			apiResources, err := paginator.All(cmd.Context(), page.limit())
+			if err != nil {
+				return fmt.Errorf("failed to list api resources: %w", err)
+			}
+			list := &models.APIResourceList{
+				TotalResults: max(paginator.TotalResults(), len(apiResources)),
+				APIResources: apiResources,
 			}
+			if err := output.render(cmd.OutOrStdout(), apiResourceListView{list: list}); err != nil {
+				return err
+			}
+			warnTruncated(cmd.ErrOrStderr(), len(apiResources), list.TotalResults, paginator.HasNext(), "api resources")
 			return nil
 		},
 	}
+	output.register(cmd)
+	page.register(cmd)
+	return cmd
+}
 
+func getAPIResourceCmd(cli *core.CLI) *cobra.Command {
+	var output OutputInputs
+	cmd := &cobra.Command{
+		Use:   "get <id|identifier>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Show an api resource",
+		Long:  "Show an api resource with its scopes and properties. The api resource is printed as YAML unless another format is requested.",
+		Example: `asgardeo apis get https://api.bank.com/loans
+  asgardeo apis get 3f7a2c1e-5b8d-4e6f-9a0b-1c2d3e4f5a6b --output json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.validate(); err != nil {
+				return err
+			}
+			apiResource, err := core.ResolveAPIResource(cmd.Context(), cli, args[0])
+			if err != nil {
+				return err
+			}
+			if !output.isRequested() {
+				output.Format = outputYAML
+			}
+			return output.render(cmd.OutOrStdout(), apiResourceView{apiResource: apiResource})
+		},
+	}
+	output.register(cmd)
+	return cmd
+}
+
+func updateAPIResourceCmd(cli *core.CLI) *cobra.Command {
+	var inputs core.APIResourceUpdateInputs
+	var name, description string
+	var requiresAuthorization bool
+	cmd := &cobra.Command{
+		Use:     "update <id|identifier>",
+		Aliases: []string{"u"},
+		Args:    cobra.ExactArgs(1),
+		Short:   "Update an api resource",
+		Long:    "Update an api resource. Only the given settings are changed, everything else is kept as is.",
+		Example: `asgardeo apis update https://api.bank.com/loans --name "Loans API"
+  asgardeo apis update https://api.bank.com/loans --requires-authorization=false`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			flags := cmd.Flags()
+			if flags.Changed("name") {
+				inputs.Name = &name
+			}
+			if flags.Changed("description") {
+				inputs.Description = &description
+			}
+			if flags.Changed("requires-authorization") {
+				inputs.RequiresAuthorization = &requiresAuthorization
+			}
+			apiResource, err := core.UpdateAPIResource(cmd.Context(), cli, args[0], inputs)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "API resource %s updated successfully.\n", apiResource.Identifier)
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&name, "name", "", "New display name of the api resource")
+	cmd.Flags().StringVar(&description, "description", "", "Description of the api resource")
+	cmd.Flags().BoolVar(&requiresAuthorization, "requires-authorization", true, "Require applications to be authorized to access the api resource")
 	return cmd
 }
 
@@ -102,3 +199,220 @@ func deleteAPIResourceCmd(cli *core.CLI) *cobra.Command {
 	cmd.Flags().StringVar(&inputs.ApiId, "api-id", "", "API Resource ID")
 	return cmd
 }
+
+func apiScopesCmd(cli *core.CLI) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scopes",
+		Short: "Manage the scopes of an api resource",
+	}
+
+	cmd.AddCommand(listAPIScopesCmd(cli))
+	cmd.AddCommand(addAPIScopesCmd(cli))
+	cmd.AddCommand(removeAPIScopesCmd(cli))
+	cmd.AddCommand(updateAPIScopeCmd(cli))
+	return cmd
+}
+
+func listAPIScopesCmd(cli *core.CLI) *cobra.Command {
+	var output OutputInputs
+	cmd := &cobra.Command{
+		Use:     "list <api>",
+		Aliases: []string{"ls"},
+		Args:    cobra.ExactArgs(1),
+		Short:   "List the scopes of an api resource",
+		Example: `asgardeo apis scopes list https://api.bank.com/loans
+  asgardeo apis scopes list https://api.bank.com/loans --output json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.validate(); err != nil {
+				return err
+			}
+			apiResource, err := core.ResolveAPIResource(cmd.Context(), cli, args[0])
+			if err != nil {
+				return err
+			}
+			return output.render(cmd.OutOrStdout(), apiScopesView{scopes: apiResource.Scopes})
+		},
+	}
+	output.register(cmd)
+	return cmd
+}
+
+func addAPIScopesCmd(cli *core.CLI) *cobra.Command {
+	var displayName, description string
+	cmd := &cobra.Command{
+		Use:   "add <api> <scope>...",
+		Args:  cobra.MinimumNArgs(2),
+		Short: "Add scopes to an api resource",
+		Long: `Add scopes to an api resource. Scope names must be unique in the organization, so names used by
+any api resource are rejected. The display name defaults to the scope name.`,
+		Example: `asgardeo apis scopes add https://api.bank.com/loans read_loans approve_loans
+  asgardeo apis scopes add https://api.bank.com/loans close_loans --display-name "Close loans"`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 2 && (displayName != "" || description != "") {
+				return fmt.Errorf("--display-name and --description can only be used when adding a single scope")
+			}
+			var scopes []models.Scope
+			for _, name := range args[1:] {
+				scopes = append(scopes, models.Scope{Name: name, DisplayName: displayName, Description: description})
+			}
+			apiResource, err := core.AddAPIScopes(cmd.Context(), cli, args[0], scopes)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Scopes %s added to api resource %s successfully.\n", strings.Join(args[1:], ", "), apiResource.Identifier)
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&displayName, "display-name", "", "Display name of the scope")
+	cmd.Flags().StringVar(&description, "description", "", "Description of the scope")
+	return cmd
+}
+
+func removeAPIScopesCmd(cli *core.CLI) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove <api> <scope>...",
+		Aliases: []string{"rm"},
+		Args:    cobra.MinimumNArgs(2),
+		Short:   "Remove scopes from an api resource",
+		Long:    "Remove scopes from an api resource. Either every given scope is removed or none is. Roles and applications lose the removed scopes.",
+		Example: `asgardeo apis scopes remove https://api.bank.com/loans approve_loans`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			apiResource, err := core.RemoveAPIScopes(cmd.Context(), cli, args[0], args[1:])
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Scopes %s removed from api resource %s successfully.\n", strings.Join(args[1:], ", "), apiResource.Identifier)
+			return nil
+		},
+	}
+	return cmd
+}
+
+func updateAPIScopeCmd(cli *core.CLI) *cobra.Command {
+	var inputs core.APIScopeUpdateInputs
+	var name, displayName, description string
+	cmd := &cobra.Command{
+		Use:     "update <api> <scope>",
+		Aliases: []string{"u"},
+		Args:    cobra.ExactArgs(2),
+		Short:   "Update a scope of an api resource",
+		Long: `Update the name, display name or description of a scope. A renamed scope must be unique in the
+organization, and roles and applications granted the old name have to be granted the new one.`,
+		Example: `asgardeo apis scopes update https://api.bank.com/loans read_loans --display-name "Read loans"
+  asgardeo apis scopes update https://api.bank.com/loans read_loans --name loans:read`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			flags := cmd.Flags()
+			if flags.Changed("name") {
+				inputs.Name = &name
+			}
+			if flags.Changed("display-name") {
+				inputs.DisplayName = &displayName
+			}
+			if flags.Changed("description") {
+				inputs.Description = &description
+			}
+			apiResource, scope, err := core.UpdateAPIScope(cmd.Context(), cli, args[0], args[1], inputs)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Scope %q of api resource %s updated successfully.\n", scope.Name, apiResource.Identifier)
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&name, "name", "", "New name of the scope")
+	cmd.Flags().StringVar(&displayName, "display-name", "", "Display name of the scope")
+	cmd.Flags().StringVar(&description, "description", "", "Description of the scope")
+	return cmd
+}
+
+type apiResourceListView struct {
+	list *models.APIResourceList
+}
+
+func (v apiResourceListView) Columns() []string {
+	return []string{"id", "name", "identifier", "type", "requiresAuthorization"}
+}
+
+func (v apiResourceListView) Rows() [][]string {
+	var rows [][]string
+	for _, api := range v.list.APIResources {
+		rows = append(rows, []string{api.ID, api.Name, api.Identifier, api.Type, strconv.FormatBool(api.RequiresAuthorization)})
+	}
+	return rows
+}
+
+func (v apiResourceListView) Names() []string {
+	var names []string
+	for _, api := range v.list.APIResources {
+		names = append(names, api.Identifier)
+	}
+	return names
+}
+
+func (v apiResourceListView) Data() interface{} {
+	return v.list
+}
+
+type apiResourceView struct {
+	apiResource *models.APIResource
+}
+
+func (v apiResourceView) Columns() []string {
+	return []string{"id", "name", "identifier", "type", "requiresAuthorization", "scopes", "properties"}
+}
+
+func (v apiResourceView) Rows() [][]string {
+	apiResource := v.apiResource
+	var scopes, properties []string
+	for _, scope := range apiResource.Scopes {
+		scopes = append(scopes, scope.Name)
+	}
+	for _, property := range apiResource.Properties {
+		properties = append(properties, property.Name+"="+property.Value)
+	}
+	return [][]string{{
+		apiResource.ID,
+		apiResource.Name,
+		apiResource.Identifier,
+		apiResource.Type,
+		strconv.FormatBool(apiResource.RequiresAuthorization),
+		strings.Join(scopes, ","),
+		strings.Join(properties, ","),
+	}}
+}
+
+func (v apiResourceView) Names() []string {
+	return []string{v.apiResource.Identifier}
+}
+
+func (v apiResourceView) Data() interface{} {
+	return v.apiResource
+}
+
+type apiScopesView struct {
+	scopes []models.Scope
+}
+
+func (v apiScopesView) Columns() []string {
+	return []string{"name", "displayName", "description"}
+}
+
+func (v apiScopesView) Rows() [][]string {
+	var rows [][]string
+	for _, scope := range v.scopes {
+		rows = append(rows, []string{scope.Name, scope.DisplayName, scope.Description})
+	}
+	return rows
+}
+
+func (v apiScopesView) Names() []string {
+	var names []string
+	for _, scope := range v.scopes {
+		names = append(names, scope.Name)
+	}
+	return names
+}
+
+func (v apiScopesView) Data() interface{} {
+	return v.scopes
+}
@@ -4,10 +4,12 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/shashimalcse/asgardeo-cli/internal/core"
 	interactive "github.com/shashimalcse/asgardeo-cli/internal/interactive/application"
+	"github.com/shashimalcse/asgardeo-cli/internal/models"
 	"github.com/spf13/cobra"
 )
 
@@ -18,58 +20,281 @@ func applicationsCmd(cli *core.CLI) *cobra.Command {
 	}
 
 	cmd.AddCommand(listApplicationsCmd(cli))
+	cmd.AddCommand(getApplicationCmd(cli))
 	cmd.AddCommand(createApplicationsCmd(cli))
+	cmd.AddCommand(updateApplicationCmd(cli))
 	cmd.AddCommand(deleteApplicationsCmd(cli))
+	cmd.AddCommand(authorizeAPICmd(cli))
+	cmd.AddCommand(authorizedAPIsCmd(cli))
+	cmd.AddCommand(loginFlowCmd(cli))
+	cmd.AddCommand(scriptCmd(cli))
+	cmd.AddCommand(applicationClaimsCmd(cli))
+	cmd.AddCommand(shareApplicationCmd(cli))
 	return cmd
 }
 
 func listApplicationsCmd(cli *core.CLI) *cobra.Command {
+	var output OutputInputs
+	var page PageInputs
 	cmd := &cobra.Command{
 		Use:     "list",
 		Aliases: []string{"ls"},
 		Args:    cobra.NoArgs,
 		Short:   "List your apps",
 		Example: `asgardeo apps list
-  asgardeo apps ls`,
+  asgardeo apps ls
+  asgardeo apps list --all --output json
+  asgardeo apps list --limit 500 --page-size 100
+  asgardeo apps list --jq '.applications[].clientId'`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			m := interactive.NewApplicationListModel(cli)
-			p := tea.NewProgram(m, tea.WithAltScreen())
+			if err := output.validate(); err != nil {
+				return err
+			}
+			if err := page.validate(); err != nil {
+				return err
+			}
+			paginator := cli.API.Application.Paginate(page.PageSize)
+			if output.IsInteractive() {
+				m := interactive.NewApplicationListModel(cli, paginator, page.limit())
+				p := tea.NewProgram(m, tea.WithAltScreen())
 
-			if _, err := p.Run(); err != nil {
-				fmt.Println("Error running program:", err)
-				os.Exit(1)
+				if _, err := p.Run(); err != nil {
+					fmt.Println("Error running program:", err)
+					os.Exit(1)
+				}
+				return nil
+			}
+			applications, err := paginator.All(cmd.Context(), page.limit())
+			if err != nil {
+				return fmt.Errorf("failed to list applications: %w", err)
 			}
+			list := &models.ApplicationList{
+				TotalResults: max(paginator.TotalResults(), len(applications)),
+				StartIndex:   1,
+				Count:        len(applications),
+				Applications: applications,
+			}
+			if err := output.render(cmd.OutOrStdout(), applicationListView{list: list}); err != nil {
+				return err
+			}
+			warnTruncated(cmd.ErrOrStderr(), len(applications), list.TotalResults, paginator.HasNext(), "applications")
 			return nil
 		},
 	}
+	output.register(cmd)
+	page.register(cmd)
+	return cmd
+}
+
+func getApplicationCmd(cli *core.CLI) *cobra.Command {
+	var output OutputInputs
+	var showSecret bool
+	cmd := &cobra.Command{
+		Use:   "get <id|name>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Show an application",
+		Long:  "Show an application including the configuration of its inbound protocol. The full application is printed as YAML unless another format is requested.",
+		Example: `asgardeo apps get my-app
+  asgardeo apps get 0b5e5d7c-7b4c-4a4e-9f2d-3c1e7c6c9a10 --output json
+  asgardeo apps get my-app --jq '.inboundProtocolConfiguration.oidc.callbackURLs'`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.validate(); err != nil {
+				return err
+			}
+			application, err := core.GetApplicationDetails(cmd.Context(), cli, args[0], showSecret)
+			if err != nil {
+				return err
+			}
+			if !output.isRequested() {
+				output.Format = outputYAML
+			}
+			return output.render(cmd.OutOrStdout(), applicationView{application: application})
+		},
+	}
+	cmd.Flags().BoolVar(&showSecret, "show-secret", false, "Include the client secret of OIDC applications")
+	output.register(cmd)
+	return cmd
+}
+
+func updateApplicationCmd(cli *core.CLI) *cobra.Command {
+	var inputs core.ApplicationUpdateInputs
+	var name, description string
+	var skipLoginConsent, skipLogoutConsent, pkce bool
+	var accessTokenExpiry, refreshTokenExpiry int
+	cmd := &cobra.Command{
+		Use:     "update <id|name>",
+		Aliases: []string{"u"},
+		Args:    cobra.ExactArgs(1),
+		Short:   "Update an application",
+		Long:    "Update an application. Only the given settings are changed, everything else is kept as is.",
+		Example: `asgardeo apps update my-app --add-callback-url https://localhost:3001
+  asgardeo apps update my-app --pkce=false --access-token-expiry 900
+  asgardeo apps update my-app --skip-login-consent=false --name my-renamed-app`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			flags := cmd.Flags()
+			if flags.Changed("name") {
+				inputs.Name = &name
+			}
+			if flags.Changed("description") {
+				inputs.Description = &description
+			}
+			if flags.Changed("skip-login-consent") {
+				inputs.SkipLoginConsent = &skipLoginConsent
+			}
+			if flags.Changed("skip-logout-consent") {
+				inputs.SkipLogoutConsent = &skipLogoutConsent
+			}
+			if flags.Changed("pkce") {
+				inputs.PKCEMandatory = &pkce
+			}
+			if flags.Changed("access-token-expiry") {
+				inputs.AccessTokenExpiry = &accessTokenExpiry
+			}
+			if flags.Changed("refresh-token-expiry") {
+				inputs.RefreshTokenExpiry = &refreshTokenExpiry
+			}
+			application, err := core.UpdateApplication(cmd.Context(), cli, args[0], inputs)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Application %q updated successfully.\n", application.Name)
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&name, "name", "", "New name of the application")
+	cmd.Flags().StringVar(&description, "description", "", "Description of the application")
+	cmd.Flags().BoolVar(&skipLoginConsent, "skip-login-consent", true, "Skip the login consent page")
+	cmd.Flags().BoolVar(&skipLogoutConsent, "skip-logout-consent", true, "Skip the logout consent page")
+	cmd.Flags().StringSliceVar(&inputs.AddCallbackURLs, "add-callback-url", nil, "Callback URL to add (repeatable)")
+	cmd.Flags().StringSliceVar(&inputs.RemoveCallbackURLs, "remove-callback-url", nil, "Callback URL to remove (repeatable)")
+	cmd.Flags().StringSliceVar(&inputs.AddAllowedOrigins, "add-allowed-origin", nil, "Allowed origin to add (repeatable)")
+	cmd.Flags().StringSliceVar(&inputs.RemoveAllowedOrigins, "remove-allowed-origin", nil, "Allowed origin to remove (repeatable)")
+	cmd.Flags().BoolVar(&pkce, "pkce", true, "Make PKCE mandatory")
+	cmd.Flags().IntVar(&accessTokenExpiry, "access-token-expiry", 0, "Access token expiry time in seconds")
+	cmd.Flags().IntVar(&refreshTokenExpiry, "refresh-token-expiry", 0, "Refresh token expiry time in seconds")
 	return cmd
 }
 
 func createApplicationsCmd(cli *core.CLI) *cobra.Command {
+	var inputs core.ApplicationCreateInputs
+	var template, fromFile, envFile string
+	var output OutputInputs
 	cmd := &cobra.Command{
 		Use:     "create",
 		Aliases: []string{"c"},
 		Args:    cobra.NoArgs,
 		Short:   "Create an application",
+		Long: "Create an application from a template. The application is created interactively unless a template or a file is given.\n" +
+			"Supported templates: " + strings.Join(core.ApplicationTemplateNames(), ", "),
 		Example: `asgardeo apps create
-  asgardeo apps c`,
+  asgardeo apps c
+  asgardeo apps create --template spa --name my-app --redirect-url https://localhost:3000 --allowed-origin https://localhost:3000
+  asgardeo apps create --template web-saml --name my-app --issuer my-app --acs-url https://localhost:8080/acs
+  asgardeo apps create --template m2m --name my-service --output json
+  asgardeo apps create --from-file app.json
+  asgardeo apps create --template web-oidc --name my-app --redirect-url https://localhost:8080/callback --env-file .env`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			m := interactive.NewApplicationCreateModel(cli)
-			p := tea.NewProgram(m, tea.WithAltScreen())
-			m1, err := p.Run()
+			if err := output.validate(); err != nil {
+				return err
+			}
+			if template == "" && fromFile == "" {
+				if output.isRequested() {
+					return fmt.Errorf("either --template or --from-file is required with --output, --jq or --go-template")
+				}
+				if !output.IsInteractive() {
+					return fmt.Errorf("either --template or --from-file is required when not running in a terminal")
+				}
+				m := interactive.NewApplicationCreateModel(cli)
+				p := tea.NewProgram(m, tea.WithAltScreen())
+				m1, err := p.Run()
+				if err != nil {
+					fmt.Println("Oh no:", err)
+					os.Exit(1)
+				}
+				if m2, ok := m1.(*interactive.ApplicationCreateModel); ok && m2.Value() != "" {
+					fmt.Print(m2.Value())
+				}
+				return nil
+			}
+			if fromFile != "" {
+				fileInputs, err := core.ReadApplicationCreateInputs(fromFile)
+				if err != nil {
+					return err
+				}
+				mergeApplicationCreateInputs(cmd, &fileInputs, inputs)
+				inputs = fileInputs
+			}
+			if template != "" {
+				inputs.Template = core.ApplicationTemplate(template)
+			}
+			// The env file is checked before the application is created, as the client secret is
+			// shown only once.
+			if envFile != "" {
+				if _, err := os.Stat(envFile); err == nil {
+					return fmt.Errorf("failed to write the env file: %s already exists", envFile)
+				} else if !os.IsNotExist(err) {
+					return fmt.Errorf("failed to write the env file: %w", err)
+				}
+			}
+			application, err := core.CreateApplication(cmd.Context(), cli, inputs)
+			if err != nil {
+				return fmt.Errorf("failed to create application: %w", err)
+			}
+			credentials, err := core.GetApplicationCredentials(cmd.Context(), cli, application)
 			if err != nil {
-				fmt.Println("Oh no:", err)
-				os.Exit(1)
+				return fmt.Errorf("application %s was created but its credentials could not be retrieved: %w", application.ID, err)
+			}
+			var envFileErr error
+			if envFile != "" {
+				envFileErr = credentials.WriteEnvFile(envFile)
+			}
+			if output.isRequested() {
+				if err := output.render(cmd.OutOrStdout(), applicationCredentialsView{credentials: credentials}); err != nil {
+					return err
+				}
+				return envFileErr
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Application %q created successfully.\n\n%s", application.Name, credentials.Summary())
+			if envFileErr != nil {
+				return envFileErr
 			}
-			if m2, ok := m1.(*interactive.ApplicationCreateModel); ok && m2.Value() != "" {
-				fmt.Print(m2.Value())
+			if envFile != "" {
+				fmt.Fprintf(cmd.OutOrStdout(), "Credentials were written to %s\n", envFile)
 			}
 			return nil
 		},
 	}
+	cmd.Flags().StringVar(&template, "template", "", "Application template: "+strings.Join(core.ApplicationTemplateNames(), "|"))
+	cmd.Flags().StringVar(&inputs.Name, "name", "", "Name of the application")
+	cmd.Flags().StringSliceVar(&inputs.RedirectURLs, "redirect-url", nil, "Authorized redirect URL (repeatable)")
+	cmd.Flags().StringSliceVar(&inputs.AllowedOrigins, "allowed-origin", nil, "Allowed origin (repeatable)")
+	cmd.Flags().StringVar(&inputs.Issuer, "issuer", "", "SAML issuer")
+	cmd.Flags().StringSliceVar(&inputs.AssertionConsumerURLs, "acs-url", nil, "SAML assertion consumer service URL (repeatable)")
+	cmd.Flags().StringVar(&fromFile, "from-file", "", "Path to a JSON file with the application inputs")
+	cmd.Flags().StringVar(&envFile, "env-file", "", "Write the credentials and endpoints of the application to a new .env file")
+	output.register(cmd)
 	return cmd
 }
 
+// mergeApplicationCreateInputs overrides the inputs read from a file with the flags that were set explicitly.
+func mergeApplicationCreateInputs(cmd *cobra.Command, target *core.ApplicationCreateInputs, flags core.ApplicationCreateInputs) {
+	if cmd.Flags().Changed("name") {
+		target.Name = flags.Name
+	}
+	if cmd.Flags().Changed("redirect-url") {
+		target.RedirectURLs = flags.RedirectURLs
+	}
+	if cmd.Flags().Changed("allowed-origin") {
+		target.AllowedOrigins = flags.AllowedOrigins
+	}
+	if cmd.Flags().Changed("issuer") {
+		target.Issuer = flags.Issuer
+	}
+	if cmd.Flags().Changed("acs-url") {
+		target.AssertionConsumerURLs = flags.AssertionConsumerURLs
+	}
+}
+
 type ApplicationDeleteInputs struct {
 	ApplicationId string
 }
@@ -101,3 +326,73 @@ func deleteApplicationsCmd(cli *core.CLI) *cobra.Command {
 	cmd.Flags().StringVar(&inputs.ApplicationId, "app-id", "", "Application ID")
 	return cmd
 }
+
+type applicationListView struct {
+	list *models.ApplicationList
+}
+
+func (v applicationListView) Columns() []string {
+	return []string{"id", "name", "clientId", "access"}
+}
+
+func (v applicationListView) Rows() [][]string {
+	var rows [][]string
+	for _, app := range v.list.Applications {
+		rows = append(rows, []string{app.ID, app.Name, app.ClientID, app.Access})
+	}
+	return rows
+}
+
+func (v applicationListView) Names() []string {
+	var names []string
+	for _, app := range v.list.Applications {
+		names = append(names, app.Name)
+	}
+	return names
+}
+
+func (v applicationListView) Data() interface{} {
+	return v.list
+}
+
+type applicationCredentialsView struct {
+	credentials *core.ApplicationCredentials
+}
+
+func (v applicationCredentialsView) Columns() []string {
+	return []string{"id", "name", "protocol", "clientId", "clientSecret", "issuer"}
+}
+
+func (v applicationCredentialsView) Rows() [][]string {
+	c := v.credentials
+	return [][]string{{c.ApplicationID, c.Name, c.Protocol, c.ClientID, c.ClientSecret, c.Issuer}}
+}
+
+func (v applicationCredentialsView) Names() []string {
+	return []string{v.credentials.Name}
+}
+
+func (v applicationCredentialsView) Data() interface{} {
+	return v.credentials
+}
+
+type applicationView struct {
+	application *models.Application
+}
+
+func (v applicationView) Columns() []string {
+	return []string{"id", "name", "clientId", "issuer", "access"}
+}
+
+func (v applicationView) Rows() [][]string {
+	a := v.application
+	return [][]string{{a.ID, a.Name, a.ClientID, a.Issuer, a.Access}}
+}
+
+func (v applicationView) Names() []string {
+	return []string{v.application.Name}
+}
+
+func (v applicationView) Data() interface{} {
+	return v.application
+}
@@ -0,0 +1,138 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/shashimalcse/asgardeo-cli/internal/core"
+	"github.com/spf13/cobra"
+)
+
+func applicationClaimsCmd(cli *core.CLI) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claims",
+		Short: "Manage the user attributes (claims) released to an application",
+	}
+
+	cmd.AddCommand(showApplicationClaimsCmd(cli))
+	cmd.AddCommand(setApplicationClaimsCmd(cli))
+	return cmd
+}
+
+func showApplicationClaimsCmd(cli *core.CLI) *cobra.Command {
+	var output OutputInputs
+	cmd := &cobra.Command{
+		Use:   "show <app>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Show the claims released to an application",
+		Long: `Show the user attributes requested by an application, the OIDC claims they are released as in its
+tokens, the OIDC scopes releasing them, and the claim the subject of its tokens is taken from.`,
+		Example: `asgardeo apps claims show my-app
+  asgardeo apps claims show my-app --output yaml`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.validate(); err != nil {
+				return err
+			}
+			_, claims, err := core.GetApplicationClaims(cmd.Context(), cli, args[0])
+			if err != nil {
+				return err
+			}
+			return output.render(cmd.OutOrStdout(), applicationClaimsView{claims: claims})
+		},
+	}
+	output.register(cmd)
+	return cmd
+}
+
+func setApplicationClaimsCmd(cli *core.CLI) *cobra.Command {
+	var inputs core.ApplicationClaimsInputs
+	var requested, mandatory []string
+	var subjectClaim string
+	var includeUserDomain, includeTenantDomain bool
+	cmd := &cobra.Command{
+		Use:   "set <app>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Set the claims released to an application",
+		Long: `Set the user attributes requested by an application and the claim the subject of its tokens is
+taken from. Only the given settings are changed.
+
+Claims are given as OIDC claims, such as email, or as local claims by URI or by the name after
+http://wso2.org/claims/. --request replaces the requested claims, and --mandatory replaces the
+requested claims users must provide. The subject claim must be one of the requested claims.`,
+		Example: `asgardeo apps claims set my-app --request email,given_name,family_name --mandatory email
+  asgardeo apps claims set my-app --subject-claim email --subject-include-tenant-domain=false`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			flags := cmd.Flags()
+			if flags.Changed("request") {
+				inputs.Requested = &requested
+			}
+			if flags.Changed("mandatory") {
+				inputs.Mandatory = &mandatory
+			}
+			if flags.Changed("subject-claim") {
+				inputs.SubjectClaim = &subjectClaim
+			}
+			if flags.Changed("subject-include-user-domain") {
+				inputs.IncludeUserDomain = &includeUserDomain
+			}
+			if flags.Changed("subject-include-tenant-domain") {
+				inputs.IncludeTenantDomain = &includeTenantDomain
+			}
+			application, claims, err := core.SetApplicationClaims(cmd.Context(), cli, args[0], inputs)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Claims of application %q updated successfully.\n\n", application.Name)
+			view := applicationClaimsView{claims: claims}
+			return renderTable(cmd.OutOrStdout(), view.Columns(), view.Rows())
+		},
+	}
+	cmd.Flags().StringSliceVar(&requested, "request", nil, "Claims requested by the application, replacing the current ones (comma separated)")
+	cmd.Flags().StringSliceVar(&mandatory, "mandatory", nil, "Requested claims users must provide (comma separated)")
+	cmd.Flags().StringVar(&subjectClaim, "subject-claim", "", "Claim the subject of the tokens is taken from")
+	cmd.Flags().BoolVar(&includeUserDomain, "subject-include-user-domain", false, "Include the user store domain in the subject")
+	cmd.Flags().BoolVar(&includeTenantDomain, "subject-include-tenant-domain", false, "Include the organization in the subject")
+	return cmd
+}
+
+type applicationClaimsView struct {
+	claims *core.ApplicationClaims
+}
+
+func (v applicationClaimsView) Columns() []string {
+	return []string{"claim", "oidcClaims", "scopes", "mandatory", "subject"}
+}
+
+func (v applicationClaimsView) Rows() [][]string {
+	var rows [][]string
+	subject := false
+	for _, claim := range v.claims.Claims {
+		isSubject := claim.URI == v.claims.Subject.Claim
+		subject = subject || isSubject
+		rows = append(rows, []string{
+			claim.URI,
+			strings.Join(claim.OIDCClaims, ","),
+			strings.Join(claim.Scopes, ","),
+			strconv.FormatBool(claim.Mandatory),
+			strconv.FormatBool(isSubject),
+		})
+	}
+	// The subject is usually the username, which does not have to be requested.
+	if !subject {
+		rows = append(rows, []string{v.claims.Subject.Claim, "sub", "openid", "false", "true"})
+	}
+	return rows
+}
+
+func (v applicationClaimsView) Names() []string {
+	var names []string
+	for _, claim := range v.claims.Claims {
+		names = append(names, claim.URI)
+	}
+	return names
+}
+
+func (v applicationClaimsView) Data() interface{} {
+	return v.claims
+}
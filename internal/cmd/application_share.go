@@ -0,0 +1,146 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/shashimalcse/asgardeo-cli/internal/core"
+	"github.com/spf13/cobra"
+)
+
+func shareApplicationCmd(cli *core.CLI) *cobra.Command {
+	var inputs core.ApplicationShareInputs
+	cmd := &cobra.Command{
+		Use:   "share <app>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Share an application with child organizations",
+		Long: `Share an application with child organizations, so that their users can sign in to it.
+
+--all-orgs shares the application with every child organization, including the organizations
+created later, while --org shares it with the given organizations in addition to the current ones.
+Organizations are given by ID or name, see 'asgardeo orgs list'.`,
+		Example: `asgardeo apps share my-app --all-orgs
+  asgardeo apps share my-app --org acme,globex
+  asgardeo apps share list my-app
+  asgardeo apps share unshare my-app --org globex`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			shares, err := core.ShareApplication(cmd.Context(), cli, args[0], inputs)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Application %q shared successfully.\n\n", shares.Application)
+			return renderApplicationShares(cmd.OutOrStdout(), shares)
+		},
+	}
+	cmd.Flags().BoolVar(&inputs.AllOrganizations, "all-orgs", false, "Share the application with every child organization")
+	cmd.Flags().StringSliceVar(&inputs.Organizations, "org", nil, "Organizations to share the application with (comma separated)")
+	cmd.MarkFlagsMutuallyExclusive("all-orgs", "org")
+	cmd.MarkFlagsOneRequired("all-orgs", "org")
+
+	cmd.AddCommand(listApplicationSharesCmd(cli))
+	cmd.AddCommand(unshareApplicationCmd(cli))
+	return cmd
+}
+
+func listApplicationSharesCmd(cli *core.CLI) *cobra.Command {
+	var output OutputInputs
+	cmd := &cobra.Command{
+		Use:     "list [<app>]",
+		Aliases: []string{"ls"},
+		Args:    cobra.MaximumNArgs(1),
+		Short:   "List the organizations applications are shared with",
+		Long:    "List the organizations that can currently see the application, or every application when none is given.",
+		Example: `asgardeo apps share list
+  asgardeo apps share list my-app --output json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.validate(); err != nil {
+				return err
+			}
+			var application string
+			if len(args) == 1 {
+				application = args[0]
+			}
+			shares, err := core.ListApplicationShares(cmd.Context(), cli, application)
+			if err != nil {
+				return err
+			}
+			return output.render(cmd.OutOrStdout(), applicationShareListView{shares: shares})
+		},
+	}
+	output.register(cmd)
+	return cmd
+}
+
+func unshareApplicationCmd(cli *core.CLI) *cobra.Command {
+	var inputs core.ApplicationShareInputs
+	cmd := &cobra.Command{
+		Use:   "unshare <app>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Stop sharing an application with child organizations",
+		Long: `Stop sharing an application with the given organizations, or with every organization. Users of
+those organizations can no longer sign in to the application.`,
+		Example: `asgardeo apps share unshare my-app --org globex
+  asgardeo apps share unshare my-app --all-orgs`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			shares, err := core.UnshareApplication(cmd.Context(), cli, args[0], inputs)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Application %q unshared successfully.\n\n", shares.Application)
+			return renderApplicationShares(cmd.OutOrStdout(), shares)
+		},
+	}
+	cmd.Flags().BoolVar(&inputs.AllOrganizations, "all-orgs", false, "Stop sharing the application with every organization")
+	cmd.Flags().StringSliceVar(&inputs.Organizations, "org", nil, "Organizations to stop sharing the application with (comma separated)")
+	cmd.MarkFlagsMutuallyExclusive("all-orgs", "org")
+	cmd.MarkFlagsOneRequired("all-orgs", "org")
+	return cmd
+}
+
+// renderApplicationShares prints the organizations the application is shared with after a change.
+func renderApplicationShares(w io.Writer, shares *core.ApplicationShares) error {
+	if len(shares.Organizations) == 0 {
+		fmt.Fprintln(w, "The application is not shared with any organization.")
+		return nil
+	}
+	fmt.Fprintln(w, "The application is shared with:")
+	var rows [][]string
+	for _, organization := range shares.Organizations {
+		rows = append(rows, []string{organization.ID, organization.Name, organization.Status})
+	}
+	return renderTable(w, []string{"id", "name", "status"}, rows)
+}
+
+type applicationShareListView struct {
+	shares []core.ApplicationShares
+}
+
+func (v applicationShareListView) Columns() []string {
+	return []string{"application", "organizations", "count"}
+}
+
+func (v applicationShareListView) Rows() [][]string {
+	var rows [][]string
+	for _, share := range v.shares {
+		var names []string
+		for _, organization := range share.Organizations {
+			names = append(names, organization.Name)
+		}
+		rows = append(rows, []string{share.Application, strings.Join(names, ","), strconv.Itoa(len(share.Organizations))})
+	}
+	return rows
+}
+
+func (v applicationShareListView) Names() []string {
+	var names []string
+	for _, share := range v.shares {
+		names = append(names, share.Application)
+	}
+	return names
+}
+
+func (v applicationShareListView) Data() interface{} {
+	return v.shares
+}
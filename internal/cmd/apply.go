@@ -0,0 +1,109 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/shashimalcse/asgardeo-cli/internal/core"
+	"github.com/spf13/cobra"
+)
+
+type ApplyInputs struct {
+	Files  []string
+	DryRun bool
+	Prune  bool
+}
+
+func applyCmd(cli *core.CLI) *cobra.Command {
+	var inputs ApplyInputs
+	cmd := &cobra.Command{
+		Use:   "apply",
+		Args:  cobra.NoArgs,
+		Short: "Apply manifests of applications and api resources",
+		Long: `Apply manifests describing applications and api resources to the tenant.
+
+Resources are matched by application name and api resource identifier, so the same manifests
+can be applied to every tenant. Missing resources are created and existing ones are updated to
+match the manifests. With --prune, resources that are not in the manifests are deleted.
+
+Applications may also give their authenticationSequence, claimConfiguration and
+advancedConfigurations, which replace the ones of the application when they differ. Unknown
+fields are rejected, and the fields written by asgardeo export for review only are reported.
+
+Example manifest:
+
+  apiResources:
+    - identifier: https://api.example.com/orders
+      name: Orders API
+      scopes:
+        - name: read:orders
+          displayName: Read orders
+  applications:
+    - name: orders-web
+      template: spa
+      redirectUrls:
+        - https://orders.example.com`,
+		Example: `asgardeo apply -f manifests/
+  asgardeo apply -f apps.yaml -f apis.yaml --dry-run
+  asgardeo apply -f manifests/ --prune`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			manifest, err := core.LoadManifests(inputs.Files)
+			if err != nil {
+				return err
+			}
+			plan, err := core.PlanApply(cmd.Context(), cli, manifest, inputs.Prune)
+			if err != nil {
+				return err
+			}
+			w := cmd.OutOrStdout()
+			for _, warning := range plan.Warnings {
+				fmt.Fprintf(w, "Warning: %s\n", warning)
+			}
+			if len(plan.Actions) == 0 {
+				fmt.Fprintf(w, "No changes. %d resource(s) are up to date.\n", plan.Unchanged)
+				return nil
+			}
+			if inputs.DryRun {
+				for _, action := range plan.Actions {
+					printApplyAction(w, action)
+				}
+				fmt.Fprintf(w, "\nPlan: %s, %d unchanged.\n", summarizeApplyPlan(plan, "to create", "to update", "to delete"), plan.Unchanged)
+				return nil
+			}
+			for _, action := range plan.Actions {
+				printApplyAction(w, action)
+				if err := action.Apply(cmd.Context()); err != nil {
+					return fmt.Errorf("failed to %s %s %q: %w", action.Operation, action.Kind, action.Name, err)
+				}
+			}
+			fmt.Fprintf(w, "\nApplied: %s, %d unchanged.\n", summarizeApplyPlan(plan, "created", "updated", "deleted"), plan.Unchanged)
+			return nil
+		},
+	}
+	cmd.Flags().StringSliceVarP(&inputs.Files, "filename", "f", nil, "Manifest file or directory (repeatable)")
+	cmd.Flags().BoolVar(&inputs.DryRun, "dry-run", false, "Print the changes without applying them")
+	cmd.Flags().BoolVar(&inputs.Prune, "prune", false, "Delete applications and api resources that are not in the manifests")
+	_ = cmd.MarkFlagRequired("filename")
+	return cmd
+}
+
+func printApplyAction(w io.Writer, action *core.ApplyAction) {
+	symbol := map[string]string{
+		core.OperationCreate: "+",
+		core.OperationUpdate: "~",
+		core.OperationDelete: "-",
+	}[action.Operation]
+	fmt.Fprintf(w, "%s %s %s (%s)\n", symbol, action.Kind, action.Name, action.Operation)
+	for _, change := range action.Changes {
+		fmt.Fprintf(w, "    %s\n", change)
+	}
+}
+
+func summarizeApplyPlan(plan *core.ApplyPlan, created, updated, deleted string) string {
+	counts := map[string]int{}
+	for _, action := range plan.Actions {
+		counts[action.Operation]++
+	}
+	return fmt.Sprintf("%d %s, %d %s, %d %s",
+		counts[core.OperationCreate], created, counts[core.OperationUpdate], updated, counts[core.OperationDelete], deleted)
+}
@@ -0,0 +1,141 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/shashimalcse/asgardeo-cli/internal/core"
+	"github.com/shashimalcse/asgardeo-cli/internal/models"
+	"github.com/spf13/cobra"
+)
+
+func authorizeAPICmd(cli *core.CLI) *cobra.Command {
+	var inputs core.AuthorizeAPIInputs
+	cmd := &cobra.Command{
+		Use:   "authorize-api <app> <api-identifier>",
+		Args:  cobra.ExactArgs(2),
+		Short: "Authorize an application to access an api resource",
+		Long: `Authorize an application to access an api resource with the given scopes.
+
+With the RBAC policy, users get the scopes granted by their roles. With no policy, the application
+can request every authorized scope. When the application is already authorized, the missing scopes
+are added to it.`,
+		Example: `asgardeo apps authorize-api my-service https://api.bank.com/loans --scopes read_loans,approve_loans
+  asgardeo apps authorize-api my-app https://api.bank.com/loans --scopes read_loans --policy none`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inputs.API = args[1]
+			authorization, err := core.AuthorizeAPI(cmd.Context(), cli, args[0], inputs)
+			if err != nil {
+				return err
+			}
+			w := cmd.OutOrStdout()
+			switch {
+			case !authorization.Existing:
+				fmt.Fprintf(w, "Application %q authorized to access %s successfully.\n", authorization.Application.Name, authorization.APIResource.Identifier)
+			case len(authorization.Added) > 0:
+				fmt.Fprintf(w, "Scopes %s of %s added to application %q successfully.\n",
+					strings.Join(authorization.Added, ", "), authorization.APIResource.Identifier, authorization.Application.Name)
+			default:
+				fmt.Fprintf(w, "Application %q is already authorized to access %s with these scopes.\n", authorization.Application.Name, authorization.APIResource.Identifier)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringSliceVar(&inputs.Scopes, "scopes", nil, "Scopes of the api resource the application can request")
+	cmd.Flags().StringVar(&inputs.Policy, "policy", core.AuthorizationPolicyRBAC, "Authorization policy: RBAC or none")
+	return cmd
+}
+
+func authorizedAPIsCmd(cli *core.CLI) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "authorized-apis",
+		Short: "Manage the api resources an application is authorized to access",
+	}
+
+	cmd.AddCommand(listAuthorizedAPIsCmd(cli))
+	cmd.AddCommand(revokeAPICmd(cli))
+	return cmd
+}
+
+func listAuthorizedAPIsCmd(cli *core.CLI) *cobra.Command {
+	var output OutputInputs
+	cmd := &cobra.Command{
+		Use:     "list <app>",
+		Aliases: []string{"ls"},
+		Args:    cobra.ExactArgs(1),
+		Short:   "List the api resources an application is authorized to access",
+		Example: `asgardeo apps authorized-apis list my-service
+  asgardeo apps authorized-apis list my-service --output json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.validate(); err != nil {
+				return err
+			}
+			_, authorizedAPIs, err := core.ListAuthorizedAPIs(cmd.Context(), cli, args[0])
+			if err != nil {
+				return err
+			}
+			return output.render(cmd.OutOrStdout(), authorizedAPIListView{authorizedAPIs: authorizedAPIs})
+		},
+	}
+	output.register(cmd)
+	return cmd
+}
+
+func revokeAPICmd(cli *core.CLI) *cobra.Command {
+	var scopes []string
+	cmd := &cobra.Command{
+		Use:   "revoke <app> <api-identifier>",
+		Args:  cobra.ExactArgs(2),
+		Short: "Revoke the access of an application to an api resource",
+		Long:  "Revoke the access of an application to an api resource, or only some of its scopes with --scopes.",
+		Example: `asgardeo apps authorized-apis revoke my-service https://api.bank.com/loans
+  asgardeo apps authorized-apis revoke my-service https://api.bank.com/loans --scopes approve_loans`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			application, authorizedAPI, err := core.RevokeAPI(cmd.Context(), cli, args[0], args[1], scopes)
+			if err != nil {
+				return err
+			}
+			if len(scopes) > 0 {
+				fmt.Fprintf(cmd.OutOrStdout(), "Scopes %s of %s revoked from application %q successfully.\n",
+					strings.Join(scopes, ", "), authorizedAPI.Identifier, application.Name)
+				return nil
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Access of application %q to %s revoked successfully.\n", application.Name, authorizedAPI.Identifier)
+			return nil
+		},
+	}
+	cmd.Flags().StringSliceVar(&scopes, "scopes", nil, "Scopes to revoke, keeping the access to the api resource")
+	return cmd
+}
+
+type authorizedAPIListView struct {
+	authorizedAPIs []models.AuthorizedAPI
+}
+
+func (v authorizedAPIListView) Columns() []string {
+	return []string{"identifier", "name", "policy", "scopes"}
+}
+
+func (v authorizedAPIListView) Rows() [][]string {
+	var rows [][]string
+	for _, authorizedAPI := range v.authorizedAPIs {
+		var scopes []string
+		for _, scope := range authorizedAPI.AuthorizedScopes {
+			scopes = append(scopes, scope.Name)
+		}
+		rows = append(rows, []string{authorizedAPI.Identifier, authorizedAPI.DisplayName, authorizedAPI.PolicyID, strings.Join(scopes, ",")})
+	}
+	return rows
+}
+
+func (v authorizedAPIListView) Names() []string {
+	var names []string
+	for _, authorizedAPI := range v.authorizedAPIs {
+		names = append(names, authorizedAPI.Identifier)
+	}
+	return names
+}
+
+func (v authorizedAPIListView) Data() interface{} {
+	return v.authorizedAPIs
+}
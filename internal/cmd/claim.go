@@ -0,0 +1,476 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/shashimalcse/asgardeo-cli/internal/core"
+	"github.com/shashimalcse/asgardeo-cli/internal/models"
+	"github.com/spf13/cobra"
+)
+
+func claimsCmd(cli *core.CLI) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claims",
+		Short: "Manage user attributes (local claims) and their mappings to OIDC and SCIM claims",
+	}
+
+	cmd.AddCommand(localClaimsCmd(cli))
+	cmd.AddCommand(claimDialectsCmd(cli))
+	cmd.AddCommand(claimMappingsCmd(cli))
+	return cmd
+}
+
+func localClaimsCmd(cli *core.CLI) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "local",
+		Short: "Manage the local claims (user attributes) of the organization",
+		Long: `Manage the local claims (user attributes) of the organization. Local claims are given by their
+URI, such as http://wso2.org/claims/department, or by the name after http://wso2.org/claims/.`,
+	}
+
+	cmd.AddCommand(listLocalClaimsCmd(cli))
+	cmd.AddCommand(getLocalClaimCmd(cli))
+	cmd.AddCommand(createLocalClaimCmd(cli))
+	cmd.AddCommand(updateLocalClaimCmd(cli))
+	cmd.AddCommand(deleteLocalClaimCmd(cli))
+	return cmd
+}
+
+func listLocalClaimsCmd(cli *core.CLI) *cobra.Command {
+	var output OutputInputs
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Args:    cobra.NoArgs,
+		Short:   "List local claims",
+		Example: `asgardeo claims local list
+  asgardeo claims local list --output csv`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.validate(); err != nil {
+				return err
+			}
+			claims, err := cli.API.Claim.ListLocalClaims(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to list local claims: %w", err)
+			}
+			return output.render(cmd.OutOrStdout(), localClaimListView{claims: claims})
+		},
+	}
+	output.register(cmd)
+	return cmd
+}
+
+func getLocalClaimCmd(cli *core.CLI) *cobra.Command {
+	var output OutputInputs
+	cmd := &cobra.Command{
+		Use:   "get <id|uri>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Show a local claim",
+		Long:  "Show a local claim with its attribute mappings. The claim is printed as YAML unless another format is requested.",
+		Example: `asgardeo claims local get department
+  asgardeo claims local get http://wso2.org/claims/department --output json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.validate(); err != nil {
+				return err
+			}
+			claim, err := core.ResolveLocalClaim(cmd.Context(), cli, args[0])
+			if err != nil {
+				return err
+			}
+			if !output.isRequested() {
+				output.Format = outputYAML
+			}
+			return output.render(cmd.OutOrStdout(), localClaimView{claim: claim})
+		},
+	}
+	output.register(cmd)
+	return cmd
+}
+
+func createLocalClaimCmd(cli *core.CLI) *cobra.Command {
+	var inputs core.LocalClaimCreateInputs
+	var output OutputInputs
+	cmd := &cobra.Command{
+		Use:     "create <uri>",
+		Aliases: []string{"c"},
+		Args:    cobra.ExactArgs(1),
+		Short:   "Create a local claim",
+		Long: `Create a local claim. The claim is stored in the user store attribute of the same name unless
+--attribute is given, in the primary user store (DEFAULT on Asgardeo, PRIMARY on WSO2 Identity
+Server) unless --userstore is given. Map it to OIDC or SCIM claims with 'claims mappings create'.`,
+		Example: `asgardeo claims local create department --display-name Department
+  asgardeo claims local create http://wso2.org/claims/employeeId --display-name "Employee ID" --attribute employeeNumber --required --supported-by-default`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.validate(); err != nil {
+				return err
+			}
+			inputs.Name = args[0]
+			claim, err := core.CreateLocalClaim(cmd.Context(), cli, inputs)
+			if err != nil {
+				return err
+			}
+			if output.isRequested() {
+				return output.render(cmd.OutOrStdout(), localClaimView{claim: claim})
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Local claim %s created successfully with ID %s.\n", claim.ClaimURI, claim.ID)
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&inputs.DisplayName, "display-name", "", "Display name of the claim (defaults to the claim name)")
+	cmd.Flags().StringVar(&inputs.Description, "description", "", "Description of the claim")
+	cmd.Flags().StringVar(&inputs.MappedAttribute, "attribute", "", "User store attribute the claim is stored in (defaults to the claim name)")
+	cmd.Flags().StringVar(&inputs.Userstore, "userstore", "", "User store the attribute is mapped in (defaults to the primary user store)")
+	cmd.Flags().BoolVar(&inputs.Required, "required", false, "Require the claim in user profiles")
+	cmd.Flags().BoolVar(&inputs.ReadOnly, "read-only", false, "Prevent users from changing the claim")
+	cmd.Flags().BoolVar(&inputs.SupportedByDefault, "supported-by-default", false, "Show the claim in user profiles")
+	cmd.Flags().StringVar(&inputs.RegEx, "regex", "", "Regular expression the values of the claim must match")
+	cmd.Flags().IntVar(&inputs.DisplayOrder, "display-order", 0, "Position of the claim in user profiles")
+	output.register(cmd)
+	return cmd
+}
+
+func updateLocalClaimCmd(cli *core.CLI) *cobra.Command {
+	var inputs core.LocalClaimUpdateInputs
+	var displayName, description, attribute, userstore, regex string
+	var required, readOnly, supportedByDefault bool
+	var displayOrder int
+	cmd := &cobra.Command{
+		Use:     "update <id|uri>",
+		Aliases: []string{"u"},
+		Args:    cobra.ExactArgs(1),
+		Short:   "Update a local claim",
+		Long: `Update a local claim. Only the given settings are changed, everything else is kept as is.
+
+--attribute changes the attribute of every user store the claim is mapped in, or only of the user
+store given with --userstore.`,
+		Example: `asgardeo claims local update department --display-name "Department name"
+  asgardeo claims local update department --attribute departmentName --userstore PRIMARY
+  asgardeo claims local update employeeId --required=false --regex '^[0-9]{6}$'`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			flags := cmd.Flags()
+			if flags.Changed("display-name") {
+				inputs.DisplayName = &displayName
+			}
+			if flags.Changed("description") {
+				inputs.Description = &description
+			}
+			if flags.Changed("attribute") {
+				inputs.MappedAttribute = &attribute
+			}
+			if flags.Changed("userstore") {
+				inputs.Userstore = &userstore
+			}
+			if flags.Changed("required") {
+				inputs.Required = &required
+			}
+			if flags.Changed("read-only") {
+				inputs.ReadOnly = &readOnly
+			}
+			if flags.Changed("supported-by-default") {
+				inputs.SupportedByDefault = &supportedByDefault
+			}
+			if flags.Changed("regex") {
+				inputs.RegEx = &regex
+			}
+			if flags.Changed("display-order") {
+				inputs.DisplayOrder = &displayOrder
+			}
+			claim, err := core.UpdateLocalClaim(cmd.Context(), cli, args[0], inputs)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Local claim %s updated successfully.\n", claim.ClaimURI)
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&displayName, "display-name", "", "Display name of the claim")
+	cmd.Flags().StringVar(&description, "description", "", "Description of the claim")
+	cmd.Flags().StringVar(&attribute, "attribute", "", "User store attribute the claim is stored in")
+	cmd.Flags().StringVar(&userstore, "userstore", "", "User store to change the attribute of (defaults to every mapped user store)")
+	cmd.Flags().BoolVar(&required, "required", true, "Require the claim in user profiles")
+	cmd.Flags().BoolVar(&readOnly, "read-only", true, "Prevent users from changing the claim")
+	cmd.Flags().BoolVar(&supportedByDefault, "supported-by-default", true, "Show the claim in user profiles")
+	cmd.Flags().StringVar(&regex, "regex", "", "Regular expression the values of the claim must match")
+	cmd.Flags().IntVar(&displayOrder, "display-order", 0, "Position of the claim in user profiles")
+	return cmd
+}
+
+func deleteLocalClaimCmd(cli *core.CLI) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "delete <id|uri>",
+		Aliases: []string{"rm"},
+		Args:    cobra.ExactArgs(1),
+		Short:   "Delete a local claim",
+		Long:    "Delete a local claim. Claims that OIDC or SCIM claims are mapped to cannot be deleted until the mappings are deleted.",
+		Example: `asgardeo claims local delete department`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			claim, err := core.DeleteLocalClaim(cmd.Context(), cli, args[0])
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Local claim %s deleted successfully.\n", claim.ClaimURI)
+			return nil
+		},
+	}
+	return cmd
+}
+
+func claimDialectsCmd(cli *core.CLI) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dialects",
+		Short: "Inspect the claim dialects of the organization",
+	}
+
+	cmd.AddCommand(listClaimDialectsCmd(cli))
+	return cmd
+}
+
+func listClaimDialectsCmd(cli *core.CLI) *cobra.Command {
+	var output OutputInputs
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Args:    cobra.NoArgs,
+		Short:   "List claim dialects",
+		Long:    "List the claim dialects of the organization. Dialects are given to the other commands by ID, by URI or by alias.",
+		Example: `asgardeo claims dialects list`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.validate(); err != nil {
+				return err
+			}
+			dialects, err := cli.API.Claim.ListDialects(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to list claim dialects: %w", err)
+			}
+			return output.render(cmd.OutOrStdout(), claimDialectListView{dialects: dialects})
+		},
+	}
+	output.register(cmd)
+	return cmd
+}
+
+func claimMappingsCmd(cli *core.CLI) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "mappings",
+		Aliases: []string{"external"},
+		Short:   "Manage the mappings of OIDC and SCIM claims to local claims",
+		Long: `Manage the claims of the OIDC, SCIM and other external dialects and the local claims they are
+mapped to. Dialects are given by ID, by URI or by alias: oidc, scim2-user, scim2-enterprise,
+scim2-custom.`,
+	}
+
+	cmd.AddCommand(listClaimMappingsCmd(cli))
+	cmd.AddCommand(createClaimMappingCmd(cli))
+	cmd.AddCommand(updateClaimMappingCmd(cli))
+	cmd.AddCommand(deleteClaimMappingCmd(cli))
+	return cmd
+}
+
+func listClaimMappingsCmd(cli *core.CLI) *cobra.Command {
+	var output OutputInputs
+	cmd := &cobra.Command{
+		Use:     "list <dialect>",
+		Aliases: []string{"ls"},
+		Args:    cobra.ExactArgs(1),
+		Short:   "List the claims of a dialect and the local claims they are mapped to",
+		Example: `asgardeo claims mappings list oidc
+  asgardeo claims mappings list urn:ietf:params:scim:schemas:extension:enterprise:2.0:User --output csv`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.validate(); err != nil {
+				return err
+			}
+			_, claims, err := core.ListExternalClaims(cmd.Context(), cli, args[0])
+			if err != nil {
+				return err
+			}
+			return output.render(cmd.OutOrStdout(), externalClaimListView{claims: claims})
+		},
+	}
+	output.register(cmd)
+	return cmd
+}
+
+func createClaimMappingCmd(cli *core.CLI) *cobra.Command {
+	var localClaim string
+	cmd := &cobra.Command{
+		Use:     "create <dialect> <claim-uri>",
+		Aliases: []string{"c"},
+		Args:    cobra.ExactArgs(2),
+		Short:   "Add a claim to a dialect, mapped to a local claim",
+		Example: `asgardeo claims mappings create oidc department --local-claim department
+  asgardeo claims mappings create scim2-custom urn:scim:schemas:extension:custom:User:department --local-claim http://wso2.org/claims/department`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			claim, err := core.CreateExternalClaim(cmd.Context(), cli, args[0], args[1], localClaim)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Claim %s of dialect %s mapped to %s successfully.\n", claim.ClaimURI, claim.ClaimDialectURI, claim.MappedLocalClaimURI)
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&localClaim, "local-claim", "", "Local claim the claim is mapped to")
+	_ = cmd.MarkFlagRequired("local-claim")
+	return cmd
+}
+
+func updateClaimMappingCmd(cli *core.CLI) *cobra.Command {
+	var localClaim string
+	cmd := &cobra.Command{
+		Use:     "update <dialect> <id|claim-uri>",
+		Aliases: []string{"u"},
+		Args:    cobra.ExactArgs(2),
+		Short:   "Map a claim of a dialect to another local claim",
+		Example: `asgardeo claims mappings update oidc department --local-claim organizationUnit`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			claim, err := core.UpdateExternalClaim(cmd.Context(), cli, args[0], args[1], localClaim)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Claim %s mapped to %s successfully.\n", claim.ClaimURI, claim.MappedLocalClaimURI)
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&localClaim, "local-claim", "", "Local claim the claim is mapped to")
+	_ = cmd.MarkFlagRequired("local-claim")
+	return cmd
+}
+
+func deleteClaimMappingCmd(cli *core.CLI) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "delete <dialect> <id|claim-uri>",
+		Aliases: []string{"rm"},
+		Args:    cobra.ExactArgs(2),
+		Short:   "Remove a claim from a dialect",
+		Example: `asgardeo claims mappings delete oidc department`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			claim, err := core.DeleteExternalClaim(cmd.Context(), cli, args[0], args[1])
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Claim %s deleted successfully.\n", claim.ClaimURI)
+			return nil
+		},
+	}
+	return cmd
+}
+
+type localClaimListView struct {
+	claims []models.LocalClaim
+}
+
+func (v localClaimListView) Columns() []string {
+	return []string{"uri", "displayName", "attribute", "required", "readOnly", "supportedByDefault"}
+}
+
+func (v localClaimListView) Rows() [][]string {
+	var rows [][]string
+	for _, claim := range v.claims {
+		rows = append(rows, localClaimRow(&claim))
+	}
+	return rows
+}
+
+func (v localClaimListView) Names() []string {
+	var names []string
+	for _, claim := range v.claims {
+		names = append(names, claim.ClaimURI)
+	}
+	return names
+}
+
+func (v localClaimListView) Data() interface{} {
+	return v.claims
+}
+
+type localClaimView struct {
+	claim *models.LocalClaim
+}
+
+func (v localClaimView) Columns() []string {
+	return localClaimListView{}.Columns()
+}
+
+func (v localClaimView) Rows() [][]string {
+	return [][]string{localClaimRow(v.claim)}
+}
+
+func (v localClaimView) Names() []string {
+	return []string{v.claim.ClaimURI}
+}
+
+func (v localClaimView) Data() interface{} {
+	return v.claim
+}
+
+func localClaimRow(claim *models.LocalClaim) []string {
+	var attributes []string
+	for _, mapping := range claim.AttributeMapping {
+		attributes = append(attributes, mapping.MappedAttribute)
+	}
+	return []string{
+		claim.ClaimURI,
+		claim.DisplayName,
+		strings.Join(attributes, ","),
+		strconv.FormatBool(claim.Required),
+		strconv.FormatBool(claim.ReadOnly),
+		strconv.FormatBool(claim.SupportedByDefault),
+	}
+}
+
+type claimDialectListView struct {
+	dialects []models.ClaimDialect
+}
+
+func (v claimDialectListView) Columns() []string {
+	return []string{"id", "uri", "alias"}
+}
+
+func (v claimDialectListView) Rows() [][]string {
+	var rows [][]string
+	for _, dialect := range v.dialects {
+		rows = append(rows, []string{dialect.ID, dialect.DialectURI, core.ClaimDialectAlias(dialect.DialectURI)})
+	}
+	return rows
+}
+
+func (v claimDialectListView) Names() []string {
+	var names []string
+	for _, dialect := range v.dialects {
+		names = append(names, dialect.DialectURI)
+	}
+	return names
+}
+
+func (v claimDialectListView) Data() interface{} {
+	return v.dialects
+}
+
+type externalClaimListView struct {
+	claims []models.ExternalClaim
+}
+
+func (v externalClaimListView) Columns() []string {
+	return []string{"id", "uri", "localClaim"}
+}
+
+func (v externalClaimListView) Rows() [][]string {
+	var rows [][]string
+	for _, claim := range v.claims {
+		rows = append(rows, []string{claim.ID, claim.ClaimURI, claim.MappedLocalClaimURI})
+	}
+	return rows
+}
+
+func (v externalClaimListView) Names() []string {
+	var names []string
+	for _, claim := range v.claims {
+		names = append(names, claim.ClaimURI)
+	}
+	return names
+}
+
+func (v externalClaimListView) Data() interface{} {
+	return v.claims
+}
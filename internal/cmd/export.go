@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/shashimalcse/asgardeo-cli/internal/core"
+	"github.com/spf13/cobra"
+)
+
+type ExportInputs struct {
+	Out string
+}
+
+func exportCmd(cli *core.CLI) *cobra.Command {
+	var inputs ExportInputs
+	cmd := &cobra.Command{
+		Use:   "export",
+		Args:  cobra.NoArgs,
+		Short: "Export the applications and api resources of the tenant",
+		Long: `Export the applications and api resources of the tenant to a directory of YAML files.
+
+Each resource is written to its own file under applications/ and api-resources/, without IDs,
+links and secrets, so the snapshot can be reviewed in pull requests and applied to another tenant
+with asgardeo apply. Files left by a previous export in these directories are replaced.
+
+Only applications and business api resources are exported, as they are the resources apply
+can sync. The other resources are left out deliberately:
+  - roles and groups refer to users, groups and applications by IDs of the tenant
+  - identity providers cannot be exported with their client secrets and certificates
+  - local claims and OIDC scopes are not synced by apply, use 'asgardeo claims' and 'asgardeo oidc-scopes'
+  - users are exported with 'asgardeo users export'
+
+The inbound protocol configuration and the roles of an application are exported for review only:
+apply syncs the redirect URLs, allowed origins, login flow, claim configuration and advanced
+configurations of applications, and warns about the rest.`,
+		Example: `asgardeo export --out ./snapshot
+  asgardeo apply -f ./snapshot --dry-run`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			snapshot, err := core.ExportTenant(cmd.Context(), cli)
+			if err != nil {
+				return err
+			}
+			files, err := snapshot.Write(inputs.Out)
+			if err != nil {
+				return err
+			}
+			w := cmd.OutOrStdout()
+			for _, warning := range snapshot.Warnings {
+				fmt.Fprintf(w, "Warning: %s\n", warning)
+			}
+			fmt.Fprintf(w, "Exported %d application(s) and %d api resource(s) to %s (%d files).\n",
+				len(snapshot.Applications), len(snapshot.APIResources), inputs.Out, len(files))
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&inputs.Out, "out", "snapshot", "Directory to write the snapshot to")
+	return cmd
+}
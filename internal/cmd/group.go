@@ -0,0 +1,332 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/shashimalcse/asgardeo-cli/internal/api"
+	"github.com/shashimalcse/asgardeo-cli/internal/core"
+	"github.com/shashimalcse/asgardeo-cli/internal/models"
+	"github.com/spf13/cobra"
+)
+
+func groupsCmd(cli *core.CLI) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "groups",
+		Short: "Manage groups and their members",
+	}
+
+	cmd.AddCommand(listGroupsCmd(cli))
+	cmd.AddCommand(getGroupCmd(cli))
+	cmd.AddCommand(createGroupCmd(cli))
+	cmd.AddCommand(renameGroupCmd(cli))
+	cmd.AddCommand(deleteGroupCmd(cli))
+	cmd.AddCommand(groupMembersCmd(cli))
+	return cmd
+}
+
+func listGroupsCmd(cli *core.CLI) *cobra.Command {
+	var output OutputInputs
+	var page PageInputs
+	var query api.SCIMQuery
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Args:    cobra.NoArgs,
+		Short:   "List groups",
+		Example: `asgardeo groups list
+  asgardeo groups list --filter 'displayName sw dev'
+  asgardeo groups list --all --output csv`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.validate(); err != nil {
+				return err
+			}
+			if err := page.validate(); err != nil {
+				return err
+			}
+			paginator := cli.API.Group.Paginate(query, page.PageSize)
+			groups, err := paginator.All(cmd.Context(), page.limit())
+			if err != nil {
+				return fmt.Errorf("failed to list groups: %w", err)
+			}
+			list := &models.GroupList{
+				TotalResults: max(paginator.TotalResults(), len(groups)),
+				StartIndex:   1,
+				ItemsPerPage: len(groups),
+				Resources:    groups,
+			}
+			if err := output.render(cmd.OutOrStdout(), groupListView{list: list}); err != nil {
+				return err
+			}
+			warnTruncated(cmd.ErrOrStderr(), len(groups), list.TotalResults, paginator.HasNext(), "groups")
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&query.Filter, "filter", "", "SCIM filter, for example 'displayName sw dev'")
+	output.register(cmd)
+	page.register(cmd)
+	return cmd
+}
+
+func getGroupCmd(cli *core.CLI) *cobra.Command {
+	var output OutputInputs
+	cmd := &cobra.Command{
+		Use:   "get <id|name>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Show a group",
+		Long:  "Show a group with its members. The group is printed as YAML unless another format is requested.",
+		Example: `asgardeo groups get devs
+  asgardeo groups get devs --output json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.validate(); err != nil {
+				return err
+			}
+			group, err := core.ResolveGroup(cmd.Context(), cli, args[0])
+			if err != nil {
+				return err
+			}
+			if !output.isRequested() {
+				output.Format = outputYAML
+			}
+			return output.render(cmd.OutOrStdout(), groupView{group: group})
+		},
+	}
+	output.register(cmd)
+	return cmd
+}
+
+func createGroupCmd(cli *core.CLI) *cobra.Command {
+	var members []string
+	var output OutputInputs
+	cmd := &cobra.Command{
+		Use:     "create <name>",
+		Aliases: []string{"c"},
+		Args:    cobra.ExactArgs(1),
+		Short:   "Create a group",
+		Example: `asgardeo groups create devs
+  asgardeo groups create devs --member alice --member bob`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.validate(); err != nil {
+				return err
+			}
+			group, err := core.CreateGroup(cmd.Context(), cli, args[0], members)
+			if err != nil {
+				return err
+			}
+			if output.isRequested() {
+				return output.render(cmd.OutOrStdout(), groupView{group: group})
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Group %q created successfully with ID %s.\n", core.GroupName(group), group.ID)
+			return nil
+		},
+	}
+	cmd.Flags().StringSliceVar(&members, "member", nil, "ID or username of a member of the group (repeatable)")
+	output.register(cmd)
+	return cmd
+}
+
+func renameGroupCmd(cli *core.CLI) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "rename <id|name> <new-name>",
+		Args:    cobra.ExactArgs(2),
+		Short:   "Rename a group",
+		Example: `asgardeo groups rename devs developers`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			group, err := core.RenameGroup(cmd.Context(), cli, args[0], args[1])
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Group %q renamed to %q successfully.\n", args[0], core.GroupName(group))
+			return nil
+		},
+	}
+	return cmd
+}
+
+func deleteGroupCmd(cli *core.CLI) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "delete <id|name>",
+		Aliases: []string{"rm"},
+		Args:    cobra.ExactArgs(1),
+		Short:   "Delete a group",
+		Long:    "Delete a group. The members of the group are not deleted.",
+		Example: `asgardeo groups delete devs`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			group, err := core.DeleteGroup(cmd.Context(), cli, args[0])
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Group %q deleted successfully.\n", core.GroupName(group))
+			return nil
+		},
+	}
+	return cmd
+}
+
+func groupMembersCmd(cli *core.CLI) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "members",
+		Short: "Manage the members of a group",
+	}
+
+	cmd.AddCommand(listGroupMembersCmd(cli))
+	cmd.AddCommand(addGroupMembersCmd(cli))
+	cmd.AddCommand(removeGroupMembersCmd(cli))
+	return cmd
+}
+
+func listGroupMembersCmd(cli *core.CLI) *cobra.Command {
+	var output OutputInputs
+	cmd := &cobra.Command{
+		Use:     "list <group>",
+		Aliases: []string{"ls"},
+		Args:    cobra.ExactArgs(1),
+		Short:   "List the members of a group",
+		Example: `asgardeo groups members list devs
+  asgardeo groups members list devs -o name`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.validate(); err != nil {
+				return err
+			}
+			group, err := core.ResolveGroup(cmd.Context(), cli, args[0])
+			if err != nil {
+				return err
+			}
+			return output.render(cmd.OutOrStdout(), groupMembersView{members: group.Members})
+		},
+	}
+	output.register(cmd)
+	return cmd
+}
+
+func addGroupMembersCmd(cli *core.CLI) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add <group> <user>...",
+		Args:  cobra.MinimumNArgs(2),
+		Short: "Add users to a group",
+		Long:  "Add users to a group. Users are given by ID or username, and users that already are members are left as they are.",
+		Example: `asgardeo groups members add devs alice bob
+  asgardeo groups members add devs 5c1b3d2e-0f0a-4f1e-9c3b-2a4d6e8f0a1b`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			change, err := core.AddGroupMembers(cmd.Context(), cli, args[0], args[1:])
+			if err != nil {
+				return err
+			}
+			printGroupMembershipChange(cmd.OutOrStdout(), change, "added to", "already a member of")
+			return nil
+		},
+	}
+	return cmd
+}
+
+func removeGroupMembersCmd(cli *core.CLI) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove <group> <user>...",
+		Aliases: []string{"rm"},
+		Args:    cobra.MinimumNArgs(2),
+		Short:   "Remove users from a group",
+		Long:    "Remove users from a group. Users are given by ID or username, and users that are not members are left as they are.",
+		Example: `asgardeo groups members remove devs alice bob`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			change, err := core.RemoveGroupMembers(cmd.Context(), cli, args[0], args[1:])
+			if err != nil {
+				return err
+			}
+			printGroupMembershipChange(cmd.OutOrStdout(), change, "removed from", "not a member of")
+			return nil
+		},
+	}
+	return cmd
+}
+
+func printGroupMembershipChange(w io.Writer, change *core.GroupMembershipChange, changed, unchanged string) {
+	name := core.GroupName(change.Group)
+	for _, userName := range change.Unchanged {
+		fmt.Fprintf(w, "User %q is %s group %q.\n", userName, unchanged, name)
+	}
+	if len(change.Changed) > 0 {
+		fmt.Fprintf(w, "%d user(s) %s group %q successfully: %s.\n", len(change.Changed), changed, name, strings.Join(change.Changed, ", "))
+	}
+}
+
+type groupListView struct {
+	list *models.GroupList
+}
+
+func (v groupListView) Columns() []string {
+	return []string{"id", "name", "members"}
+}
+
+func (v groupListView) Rows() [][]string {
+	var rows [][]string
+	for _, group := range v.list.Resources {
+		rows = append(rows, groupRow(&group))
+	}
+	return rows
+}
+
+func (v groupListView) Names() []string {
+	var names []string
+	for _, group := range v.list.Resources {
+		names = append(names, core.GroupName(&group))
+	}
+	return names
+}
+
+func (v groupListView) Data() interface{} {
+	return v.list
+}
+
+type groupView struct {
+	group *models.Group
+}
+
+func (v groupView) Columns() []string {
+	return groupListView{}.Columns()
+}
+
+func (v groupView) Rows() [][]string {
+	return [][]string{groupRow(v.group)}
+}
+
+func (v groupView) Names() []string {
+	return []string{core.GroupName(v.group)}
+}
+
+func (v groupView) Data() interface{} {
+	return v.group
+}
+
+func groupRow(group *models.Group) []string {
+	return []string{group.ID, core.GroupName(group), strconv.Itoa(len(group.Members))}
+}
+
+type groupMembersView struct {
+	members []models.GroupMember
+}
+
+func (v groupMembersView) Columns() []string {
+	return []string{"id", "userName"}
+}
+
+func (v groupMembersView) Rows() [][]string {
+	var rows [][]string
+	for _, member := range v.members {
+		rows = append(rows, []string{member.Value, member.Display})
+	}
+	return rows
+}
+
+func (v groupMembersView) Names() []string {
+	var names []string
+	for _, member := range v.members {
+		names = append(names, member.Display)
+	}
+	return names
+}
+
+func (v groupMembersView) Data() interface{} {
+	return v.members
+}
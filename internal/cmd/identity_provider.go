@@ -0,0 +1,514 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/shashimalcse/asgardeo-cli/internal/core"
+	"github.com/shashimalcse/asgardeo-cli/internal/models"
+	"github.com/spf13/cobra"
+)
+
+func identityProvidersCmd(cli *core.CLI) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "idps",
+		Aliases: []string{"connections"},
+		Short:   "Manage identity providers (connections)",
+	}
+
+	cmd.AddCommand(listIdentityProvidersCmd(cli))
+	cmd.AddCommand(getIdentityProviderCmd(cli))
+	cmd.AddCommand(createIdentityProviderCmd(cli))
+	cmd.AddCommand(updateIdentityProviderCmd(cli))
+	cmd.AddCommand(deleteIdentityProviderCmd(cli))
+	cmd.AddCommand(federatedAuthenticatorsCmd(cli))
+	cmd.AddCommand(jitProvisioningCmd(cli))
+	return cmd
+}
+
+func listIdentityProvidersCmd(cli *core.CLI) *cobra.Command {
+	var output OutputInputs
+	var page PageInputs
+	var filter string
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Args:    cobra.NoArgs,
+		Short:   "List identity providers",
+		Example: `asgardeo idps list
+  asgardeo idps list --filter 'name sw Google'
+  asgardeo idps list --all --output csv`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.validate(); err != nil {
+				return err
+			}
+			if err := page.validate(); err != nil {
+				return err
+			}
+			paginator := cli.API.IDP.Paginate(filter, page.PageSize)
+			idps, err := paginator.All(cmd.Context(), page.limit())
+			if err != nil {
+				return fmt.Errorf("failed to list identity providers: %w", err)
+			}
+			list := &models.IdentityProviderList{
+				TotalResults:      max(paginator.TotalResults(), len(idps)),
+				StartIndex:        1,
+				Count:             len(idps),
+				IdentityProviders: idps,
+			}
+			if err := output.render(cmd.OutOrStdout(), identityProviderListView{list: list}); err != nil {
+				return err
+			}
+			warnTruncated(cmd.ErrOrStderr(), len(idps), list.TotalResults, paginator.HasNext(), "identity providers")
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&filter, "filter", "", "Filter, for example 'name sw Google'")
+	output.register(cmd)
+	page.register(cmd)
+	return cmd
+}
+
+func getIdentityProviderCmd(cli *core.CLI) *cobra.Command {
+	var output OutputInputs
+	cmd := &cobra.Command{
+		Use:   "get <id|name>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Show an identity provider",
+		Long:  "Show an identity provider with its federated authenticators. The identity provider is printed as YAML unless another format is requested.",
+		Example: `asgardeo idps get Google
+  asgardeo idps get Google --output json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.validate(); err != nil {
+				return err
+			}
+			idp, err := core.ResolveIdentityProvider(cmd.Context(), cli, args[0])
+			if err != nil {
+				return err
+			}
+			if !output.isRequested() {
+				output.Format = outputYAML
+			}
+			return output.render(cmd.OutOrStdout(), identityProviderView{idp: idp})
+		},
+	}
+	output.register(cmd)
+	return cmd
+}
+
+func createIdentityProviderCmd(cli *core.CLI) *cobra.Command {
+	var inputs core.IdentityProviderCreateInputs
+	var template string
+	var output OutputInputs
+	cmd := &cobra.Command{
+		Use:     "create",
+		Aliases: []string{"c"},
+		Args:    cobra.NoArgs,
+		Short:   "Create an identity provider",
+		Long: `Create an identity provider from a template. The callback URL of the organization is set on the
+federated authenticator, and has to be registered with the identity provider.
+Supported templates: ` + strings.Join(core.IdentityProviderTemplateNames(), ", "),
+		Example: `asgardeo idps create --template google --name Google --client-id <id> --client-secret <secret>
+  asgardeo idps create --template github --name GitHub --client-id <id> --client-secret <secret> --scope user:email --scope read:org
+  asgardeo idps create --template microsoft --name "Azure AD" --microsoft-tenant contoso.onmicrosoft.com --client-id <id> --client-secret <secret>
+  asgardeo idps create --template oidc --name Okta --client-id <id> --client-secret <secret> \
+    --authorize-url https://acme.okta.com/oauth2/v1/authorize --token-url https://acme.okta.com/oauth2/v1/token \
+    --jwks-url https://acme.okta.com/oauth2/v1/keys --issuer https://acme.okta.com
+  asgardeo idps create --template saml --name ADFS --sp-entity-id asgardeo-acme --metadata-url https://adfs.acme.com/FederationMetadata/2007-06/FederationMetadata.xml
+  asgardeo idps create --template saml --name Shibboleth --sp-entity-id asgardeo-acme --metadata-file idp-metadata.xml`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.validate(); err != nil {
+				return err
+			}
+			inputs.Template = core.IdentityProviderTemplate(template)
+			idp, err := core.CreateIdentityProvider(cmd.Context(), cli, inputs)
+			if err != nil {
+				return err
+			}
+			if output.isRequested() {
+				return output.render(cmd.OutOrStdout(), identityProviderView{idp: idp})
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Identity provider %q created successfully with ID %s.\n", idp.Name, idp.ID)
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&template, "template", "", "Identity provider template: "+strings.Join(core.IdentityProviderTemplateNames(), "|"))
+	cmd.Flags().StringVar(&inputs.Name, "name", "", "Name of the identity provider")
+	cmd.Flags().StringVar(&inputs.Description, "description", "", "Description of the identity provider")
+	cmd.Flags().StringVar(&inputs.ClientID, "client-id", "", "Client ID registered with the identity provider")
+	cmd.Flags().StringVar(&inputs.ClientSecret, "client-secret", "", "Client secret registered with the identity provider")
+	cmd.Flags().StringSliceVar(&inputs.Scopes, "scope", nil, "Scope requested from the identity provider (repeatable, defaults depend on the template)")
+	cmd.Flags().StringVar(&inputs.MicrosoftTenant, "microsoft-tenant", "", "Azure AD tenant of the microsoft template (default common)")
+	cmd.Flags().StringVar(&inputs.AuthorizeURL, "authorize-url", "", "Authorization endpoint of the OIDC identity provider")
+	cmd.Flags().StringVar(&inputs.TokenURL, "token-url", "", "Token endpoint of the OIDC identity provider")
+	cmd.Flags().StringVar(&inputs.UserInfoURL, "userinfo-url", "", "Userinfo endpoint of the OIDC identity provider")
+	cmd.Flags().StringVar(&inputs.LogoutURL, "logout-url", "", "Logout endpoint of the OIDC identity provider")
+	cmd.Flags().StringVar(&inputs.JWKSURL, "jwks-url", "", "JWKS endpoint of the OIDC identity provider")
+	cmd.Flags().StringVar(&inputs.Issuer, "issuer", "", "Issuer of the tokens of the OIDC identity provider")
+	cmd.Flags().StringVar(&inputs.SPEntityID, "sp-entity-id", "", "Entity ID of the organization at the SAML identity provider")
+	cmd.Flags().StringVar(&inputs.IdPEntityID, "idp-entity-id", "", "Entity ID of the SAML identity provider")
+	cmd.Flags().StringVar(&inputs.SSOURL, "sso-url", "", "Single sign-on URL of the SAML identity provider")
+	cmd.Flags().StringVar(&inputs.MetadataURL, "metadata-url", "", "URL of the SAML metadata of the identity provider")
+	cmd.Flags().StringVar(&inputs.MetadataFile, "metadata-file", "", "Path to the SAML metadata of the identity provider")
+	output.register(cmd)
+	return cmd
+}
+
+func updateIdentityProviderCmd(cli *core.CLI) *cobra.Command {
+	var inputs core.IdentityProviderUpdateInputs
+	var name, description string
+	var enabled bool
+	cmd := &cobra.Command{
+		Use:     "update <id|name>",
+		Aliases: []string{"u"},
+		Args:    cobra.ExactArgs(1),
+		Short:   "Update an identity provider",
+		Long:    "Update an identity provider. Only the given settings are changed, everything else is kept as is.",
+		Example: `asgardeo idps update Google --description "Google Workspace of Acme"
+  asgardeo idps update Okta --enabled=false`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			flags := cmd.Flags()
+			if flags.Changed("name") {
+				inputs.Name = &name
+			}
+			if flags.Changed("description") {
+				inputs.Description = &description
+			}
+			if flags.Changed("enabled") {
+				inputs.Enabled = &enabled
+			}
+			idp, err := core.UpdateIdentityProvider(cmd.Context(), cli, args[0], inputs)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Identity provider %q updated successfully.\n", idp.Name)
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&name, "name", "", "New name of the identity provider")
+	cmd.Flags().StringVar(&description, "description", "", "Description of the identity provider")
+	cmd.Flags().BoolVar(&enabled, "enabled", true, "Enable the identity provider")
+	return cmd
+}
+
+func deleteIdentityProviderCmd(cli *core.CLI) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "delete <id|name>",
+		Aliases: []string{"rm"},
+		Args:    cobra.ExactArgs(1),
+		Short:   "Delete an identity provider",
+		Long:    "Delete an identity provider. Identity providers used in the login flow of an application cannot be deleted.",
+		Example: `asgardeo idps delete Okta`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			idp, err := core.DeleteIdentityProvider(cmd.Context(), cli, args[0])
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Identity provider %q deleted successfully.\n", idp.Name)
+			return nil
+		},
+	}
+	return cmd
+}
+
+func federatedAuthenticatorsCmd(cli *core.CLI) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "authenticators",
+		Short: "Manage the federated authenticators of an identity provider",
+	}
+
+	cmd.AddCommand(listFederatedAuthenticatorsCmd(cli))
+	cmd.AddCommand(getFederatedAuthenticatorCmd(cli))
+	cmd.AddCommand(updateFederatedAuthenticatorCmd(cli))
+	return cmd
+}
+
+func listFederatedAuthenticatorsCmd(cli *core.CLI) *cobra.Command {
+	var output OutputInputs
+	cmd := &cobra.Command{
+		Use:     "list <idp>",
+		Aliases: []string{"ls"},
+		Args:    cobra.ExactArgs(1),
+		Short:   "List the federated authenticators of an identity provider",
+		Example: `asgardeo idps authenticators list Google`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.validate(); err != nil {
+				return err
+			}
+			idp, err := core.ResolveIdentityProvider(cmd.Context(), cli, args[0])
+			if err != nil {
+				return err
+			}
+			return output.render(cmd.OutOrStdout(), federatedAuthenticatorListView{authenticators: core.FederatedAuthenticators(idp)})
+		},
+	}
+	output.register(cmd)
+	return cmd
+}
+
+func getFederatedAuthenticatorCmd(cli *core.CLI) *cobra.Command {
+	var output OutputInputs
+	cmd := &cobra.Command{
+		Use:   "get <idp> <id|name>",
+		Args:  cobra.ExactArgs(2),
+		Short: "Show a federated authenticator with its properties",
+		Long:  "Show a federated authenticator with its properties. The authenticator is printed as YAML unless another format is requested.",
+		Example: `asgardeo idps authenticators get Google GoogleOIDCAuthenticator
+  asgardeo idps authenticators get Okta OpenIDConnectAuthenticator --output json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.validate(); err != nil {
+				return err
+			}
+			idp, err := core.ResolveIdentityProvider(cmd.Context(), cli, args[0])
+			if err != nil {
+				return err
+			}
+			authenticator, err := core.ResolveFederatedAuthenticator(cmd.Context(), cli, idp, args[1])
+			if err != nil {
+				return err
+			}
+			if !output.isRequested() {
+				output.Format = outputYAML
+			}
+			return output.render(cmd.OutOrStdout(), federatedAuthenticatorView{authenticator: authenticator})
+		},
+	}
+	output.register(cmd)
+	return cmd
+}
+
+func updateFederatedAuthenticatorCmd(cli *core.CLI) *cobra.Command {
+	var inputs core.FederatedAuthenticatorUpdateInputs
+	var enabled, isDefault bool
+	cmd := &cobra.Command{
+		Use:     "update <idp> <id|name>",
+		Aliases: []string{"u"},
+		Args:    cobra.ExactArgs(2),
+		Short:   "Update a federated authenticator",
+		Long:    "Update a federated authenticator. Only the given settings and properties are changed, everything else is kept as is.",
+		Example: `asgardeo idps authenticators update Google GoogleOIDCAuthenticator --set ClientSecret=<secret>
+  asgardeo idps authenticators update Okta OpenIDConnectAuthenticator --set "AdditionalQueryParameters=scope=openid email groups"
+  asgardeo idps authenticators update Okta OpenIDConnectAuthenticator --unset OIDCLogoutEPUrl --enabled=false`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			flags := cmd.Flags()
+			if flags.Changed("enabled") {
+				inputs.Enabled = &enabled
+			}
+			if flags.Changed("default") {
+				inputs.Default = &isDefault
+			}
+			idp, authenticator, err := core.UpdateFederatedAuthenticator(cmd.Context(), cli, args[0], args[1], inputs)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Federated authenticator %s of identity provider %q updated successfully.\n", authenticator.Name, idp.Name)
+			return nil
+		},
+	}
+	cmd.Flags().StringArrayVar(&inputs.Properties, "set", nil, "Property to set, as key=value (repeatable)")
+	cmd.Flags().StringSliceVar(&inputs.RemoveProperties, "unset", nil, "Key of a property to remove (repeatable)")
+	cmd.Flags().BoolVar(&enabled, "enabled", true, "Enable the authenticator")
+	cmd.Flags().BoolVar(&isDefault, "default", true, "Make the authenticator the default authenticator of the identity provider")
+	return cmd
+}
+
+func jitProvisioningCmd(cli *core.CLI) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "jit",
+		Short: "Manage the just-in-time provisioning of users of an identity provider",
+	}
+
+	cmd.AddCommand(showJITProvisioningCmd(cli))
+	cmd.AddCommand(setJITProvisioningCmd(cli))
+	return cmd
+}
+
+func showJITProvisioningCmd(cli *core.CLI) *cobra.Command {
+	var output OutputInputs
+	cmd := &cobra.Command{
+		Use:     "show <idp>",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Show the JIT provisioning settings of an identity provider",
+		Example: `asgardeo idps jit show Google`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.validate(); err != nil {
+				return err
+			}
+			_, jit, err := core.GetJITProvisioning(cmd.Context(), cli, args[0])
+			if err != nil {
+				return err
+			}
+			return output.render(cmd.OutOrStdout(), jitProvisioningView{jit: jit})
+		},
+	}
+	output.register(cmd)
+	return cmd
+}
+
+func setJITProvisioningCmd(cli *core.CLI) *cobra.Command {
+	var inputs core.JITProvisioningInputs
+	var enabled, associateLocalUser bool
+	var scheme, userstore string
+	cmd := &cobra.Command{
+		Use:   "set <idp>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Update the JIT provisioning settings of an identity provider",
+		Long: `Update the JIT provisioning settings of an identity provider. Only the given settings are changed.
+Users are provisioned to the primary user store (DEFAULT on Asgardeo, PRIMARY on WSO2 Identity
+Server) when no user store is set. Supported schemes: ` + strings.Join(core.JITSchemeNames(), ", "),
+		Example: `asgardeo idps jit set Google --enabled --scheme provision-silently
+  asgardeo idps jit set Okta --associate-local-user
+  asgardeo idps jit set Okta --enabled=false`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			flags := cmd.Flags()
+			if flags.Changed("enabled") {
+				inputs.Enabled = &enabled
+			}
+			if flags.Changed("scheme") {
+				inputs.Scheme = &scheme
+			}
+			if flags.Changed("userstore") {
+				inputs.Userstore = &userstore
+			}
+			if flags.Changed("associate-local-user") {
+				inputs.AssociateLocalUser = &associateLocalUser
+			}
+			idp, _, err := core.SetJITProvisioning(cmd.Context(), cli, args[0], inputs)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "JIT provisioning of identity provider %q updated successfully.\n", idp.Name)
+			return nil
+		},
+	}
+	cmd.Flags().BoolVar(&enabled, "enabled", true, "Provision users when they first sign in through the identity provider")
+	cmd.Flags().StringVar(&scheme, "scheme", "", "Provisioning scheme: "+strings.Join(core.JITSchemeNames(), "|"))
+	cmd.Flags().StringVar(&userstore, "userstore", "", "User store the users are provisioned to (defaults to the primary user store)")
+	cmd.Flags().BoolVar(&associateLocalUser, "associate-local-user", true, "Associate provisioned users with existing local users of the same username")
+	return cmd
+}
+
+type identityProviderListView struct {
+	list *models.IdentityProviderList
+}
+
+func (v identityProviderListView) Columns() []string {
+	return []string{"id", "name", "description", "enabled"}
+}
+
+func (v identityProviderListView) Rows() [][]string {
+	var rows [][]string
+	for _, idp := range v.list.IdentityProviders {
+		rows = append(rows, []string{idp.ID, idp.Name, idp.Description, strconv.FormatBool(idp.IsEnabled)})
+	}
+	return rows
+}
+
+func (v identityProviderListView) Names() []string {
+	var names []string
+	for _, idp := range v.list.IdentityProviders {
+		names = append(names, idp.Name)
+	}
+	return names
+}
+
+func (v identityProviderListView) Data() interface{} {
+	return v.list
+}
+
+type identityProviderView struct {
+	idp *models.IdentityProvider
+}
+
+func (v identityProviderView) Columns() []string {
+	return []string{"id", "name", "enabled", "authenticators"}
+}
+
+func (v identityProviderView) Rows() [][]string {
+	var authenticators []string
+	for _, authenticator := range core.FederatedAuthenticators(v.idp) {
+		authenticators = append(authenticators, authenticator.Name)
+	}
+	return [][]string{{v.idp.ID, v.idp.Name, strconv.FormatBool(v.idp.IsEnabled), strings.Join(authenticators, ",")}}
+}
+
+func (v identityProviderView) Names() []string {
+	return []string{v.idp.Name}
+}
+
+func (v identityProviderView) Data() interface{} {
+	return v.idp
+}
+
+type federatedAuthenticatorListView struct {
+	authenticators []models.FederatedAuthenticator
+}
+
+func (v federatedAuthenticatorListView) Columns() []string {
+	return []string{"id", "name", "enabled", "default"}
+}
+
+func (v federatedAuthenticatorListView) Rows() [][]string {
+	var rows [][]string
+	for _, authenticator := range v.authenticators {
+		rows = append(rows, federatedAuthenticatorRow(&authenticator))
+	}
+	return rows
+}
+
+func (v federatedAuthenticatorListView) Names() []string {
+	var names []string
+	for _, authenticator := range v.authenticators {
+		names = append(names, authenticator.Name)
+	}
+	return names
+}
+
+func (v federatedAuthenticatorListView) Data() interface{} {
+	return v.authenticators
+}
+
+type federatedAuthenticatorView struct {
+	authenticator *models.FederatedAuthenticator
+}
+
+func (v federatedAuthenticatorView) Columns() []string {
+	return federatedAuthenticatorListView{}.Columns()
+}
+
+func (v federatedAuthenticatorView) Rows() [][]string {
+	return [][]string{federatedAuthenticatorRow(v.authenticator)}
+}
+
+func (v federatedAuthenticatorView) Names() []string {
+	return []string{v.authenticator.Name}
+}
+
+func (v federatedAuthenticatorView) Data() interface{} {
+	return v.authenticator
+}
+
+func federatedAuthenticatorRow(authenticator *models.FederatedAuthenticator) []string {
+	return []string{authenticator.AuthenticatorID, authenticator.Name, strconv.FormatBool(authenticator.IsEnabled), strconv.FormatBool(authenticator.IsDefault)}
+}
+
+type jitProvisioningView struct {
+	jit *models.JITProvisioning
+}
+
+func (v jitProvisioningView) Columns() []string {
+	return []string{"enabled", "scheme", "userstore", "associateLocalUser"}
+}
+
+func (v jitProvisioningView) Rows() [][]string {
+	return [][]string{{strconv.FormatBool(v.jit.IsEnabled), v.jit.Scheme, v.jit.Userstore, strconv.FormatBool(v.jit.AssociateLocalUser)}}
+}
+
+func (v jitProvisioningView) Names() []string {
+	return []string{v.jit.Scheme}
+}
+
+func (v jitProvisioningView) Data() interface{} {
+	return v.jit
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/shashimalcse/asgardeo-cli/internal/config"
 	"github.com/shashimalcse/asgardeo-cli/internal/core"
 	"github.com/shashimalcse/asgardeo-cli/internal/interactive"
 	"github.com/shashimalcse/asgardeo-cli/internal/models"
@@ -16,13 +17,15 @@ func loginCmd(cli *core.CLI) *cobra.Command {
 	var verbose bool
 
 	cmd := &cobra.Command{
-		Use:     "login",
-		Short:   "Authenticate the Asgardeo CLI",
-		Example: "asgardeo login",
+		Use:   "login",
+		Short: "Authenticate the Asgardeo CLI",
+		Example: `asgardeo login
+  asgardeo login --tenant <tenant> --client-id <client-id> --client-secret <client-secret>
+  asgardeo login --server https://localhost:9443 --tenant carbon.super --client-id <client-id> --client-secret <client-secret>`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// Determine if we should use interactive mode
 			if !inputs.IsLoggingInAsAMachine() {
-				result := runInteractiveLogin(cli)
+				result := runInteractiveLogin(cli, inputs.Server)
 				if result.IsError {
 					return errors.New(result.Message)
 				} else {
@@ -36,12 +39,13 @@ func loginCmd(cli *core.CLI) *cobra.Command {
 	cmd.Flags().StringVar(&inputs.ClientID, "client-id", "", "Client ID")
 	cmd.Flags().StringVar(&inputs.ClientSecret, "client-secret", "", "Client Secret")
 	cmd.Flags().StringVar(&inputs.Tenant, "tenant", "", "Tenant")
+	cmd.Flags().StringVar(&inputs.Server, "server", "", "Server URL of Asgardeo or WSO2 Identity Server (default \""+config.DefaultServer+"\")")
 	cmd.MarkFlagsRequiredTogether("client-id", "client-secret", "tenant")
 	return cmd
 }
 
-func runInteractiveLogin(cli *core.CLI) models.OutputResult {
-	m := interactive.NewLoginModel(cli)
+func runInteractiveLogin(cli *core.CLI, server string) models.OutputResult {
+	m := interactive.NewLoginModel(cli, server)
 	p := tea.NewProgram(m, tea.WithAltScreen())
 	m1, err := p.Run()
 	if err != nil {
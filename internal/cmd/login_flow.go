@@ -0,0 +1,164 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/shashimalcse/asgardeo-cli/internal/core"
+	"github.com/spf13/cobra"
+)
+
+func loginFlowCmd(cli *core.CLI) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "login-flow",
+		Short: "Manage the login flow (authentication sequence) of an application",
+	}
+
+	cmd.AddCommand(showLoginFlowCmd(cli))
+	cmd.AddCommand(setLoginFlowCmd(cli))
+	return cmd
+}
+
+func showLoginFlowCmd(cli *core.CLI) *cobra.Command {
+	var output OutputInputs
+	cmd := &cobra.Command{
+		Use:   "show <app>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Show the login flow of an application",
+		Long: `Show the steps of the login flow of an application and the authenticators offered in each step.
+The YAML output can be edited and given back to 'apps login-flow set'.`,
+		Example: `asgardeo apps login-flow show my-app
+  asgardeo apps login-flow show my-app --output yaml > flow.yaml`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.validate(); err != nil {
+				return err
+			}
+			_, flow, err := core.GetLoginFlow(cmd.Context(), cli, args[0])
+			if err != nil {
+				return err
+			}
+			return output.render(cmd.OutOrStdout(), loginFlowView{flow: flow})
+		},
+	}
+	output.register(cmd)
+	return cmd
+}
+
+func setLoginFlowCmd(cli *core.CLI) *cobra.Command {
+	var file string
+	var steps []string
+	var subjectStep, attributeStep int
+	cmd := &cobra.Command{
+		Use:   "set <app>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Replace the login flow of an application",
+		Long: `Replace the login flow of an application with the steps of a YAML file, or with the steps given by --step.
+
+Local authenticators are given by name or by alias (basic, totp, email-otp, sms-otp, magic-link,
+passkey, backup-code, identifier-first), and identity providers by name. The authenticators and
+identity providers are checked against the ones of the organization before the flow is saved. The
+conditional authentication script of the application is kept.
+
+A login flow file looks like:
+
+  steps:
+    - options:
+        - authenticator: basic
+        - idp: Google
+    - options:
+        - authenticator: totp
+        - authenticator: email-otp
+  subjectStep: 1
+  attributeStep: 1`,
+		Example: `asgardeo apps login-flow set my-app --step basic,Google --step totp
+  asgardeo apps login-flow set my-app -f flow.yaml
+  asgardeo apps login-flow show my-app -o yaml | sed 's/totp/email-otp/' | asgardeo apps login-flow set my-app -f -`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var flow *core.LoginFlow
+			switch {
+			case file != "" && len(steps) > 0:
+				return fmt.Errorf("use either --file or --step, not both")
+			case file != "":
+				var r io.Reader = cmd.InOrStdin()
+				if file != "-" {
+					f, err := os.Open(file)
+					if err != nil {
+						return fmt.Errorf("failed to read login flow: %w", err)
+					}
+					defer f.Close()
+					r = f
+				}
+				var err error
+				if flow, err = core.ReadLoginFlow(r); err != nil {
+					return err
+				}
+			case len(steps) > 0:
+				flow = core.ParseLoginFlowSteps(steps)
+			default:
+				return fmt.Errorf("either --file or --step is required")
+			}
+			if cmd.Flags().Changed("subject-step") {
+				flow.SubjectStep = subjectStep
+			}
+			if cmd.Flags().Changed("attribute-step") {
+				flow.AttributeStep = attributeStep
+			}
+			application, saved, err := core.SetLoginFlow(cmd.Context(), cli, args[0], flow)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Login flow of application %q updated successfully.\n\n", application.Name)
+			return renderTable(cmd.OutOrStdout(), loginFlowView{}.Columns(), loginFlowView{flow: saved}.Rows())
+		},
+	}
+	cmd.Flags().StringVarP(&file, "file", "f", "", "YAML or JSON file of the login flow, or - to read it from the standard input")
+	cmd.Flags().StringArrayVar(&steps, "step", nil, "Comma separated authenticators and identity providers of a step (repeatable, in order)")
+	cmd.Flags().IntVar(&subjectStep, "subject-step", 1, "Step the user identity is taken from")
+	cmd.Flags().IntVar(&attributeStep, "attribute-step", 1, "Step the user attributes are taken from")
+	return cmd
+}
+
+type loginFlowView struct {
+	flow *core.LoginFlow
+}
+
+func (v loginFlowView) Columns() []string {
+	return []string{"step", "authenticator", "idp", "subject", "attributes"}
+}
+
+func (v loginFlowView) Rows() [][]string {
+	var rows [][]string
+	for i, step := range v.flow.Steps {
+		number := i + 1
+		for _, option := range step.Options {
+			idp := option.IDP
+			if idp == "" {
+				idp = "LOCAL"
+			}
+			rows = append(rows, []string{
+				strconv.Itoa(number),
+				option.Authenticator,
+				idp,
+				strconv.FormatBool(number == max(v.flow.SubjectStep, 1)),
+				strconv.FormatBool(number == max(v.flow.AttributeStep, 1)),
+			})
+		}
+	}
+	return rows
+}
+
+func (v loginFlowView) Names() []string {
+	var names []string
+	for _, step := range v.flow.Steps {
+		for _, option := range step.Options {
+			names = append(names, option.Authenticator)
+		}
+	}
+	return names
+}
+
+func (v loginFlowView) Data() interface{} {
+	return v.flow
+}
@@ -0,0 +1,218 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/shashimalcse/asgardeo-cli/internal/core"
+	"github.com/shashimalcse/asgardeo-cli/internal/models"
+	"github.com/spf13/cobra"
+)
+
+func oidcScopesCmd(cli *core.CLI) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "oidc-scopes",
+		Short: "Manage OIDC scopes and the claims they release",
+		Long: `Manage the OpenID Connect scopes of the organization and the claims of the OIDC dialect they
+release to applications requesting them.`,
+	}
+
+	cmd.AddCommand(listOIDCScopesCmd(cli))
+	cmd.AddCommand(getOIDCScopeCmd(cli))
+	cmd.AddCommand(createOIDCScopeCmd(cli))
+	cmd.AddCommand(updateOIDCScopeCmd(cli))
+	cmd.AddCommand(deleteOIDCScopeCmd(cli))
+	return cmd
+}
+
+func listOIDCScopesCmd(cli *core.CLI) *cobra.Command {
+	var output OutputInputs
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Args:    cobra.NoArgs,
+		Short:   "List OIDC scopes",
+		Example: `asgardeo oidc-scopes list
+  asgardeo oidc-scopes list --output yaml`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.validate(); err != nil {
+				return err
+			}
+			scopes, err := cli.API.OIDCScope.List(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to list OIDC scopes: %w", err)
+			}
+			return output.render(cmd.OutOrStdout(), oidcScopeListView{scopes: scopes})
+		},
+	}
+	output.register(cmd)
+	return cmd
+}
+
+func getOIDCScopeCmd(cli *core.CLI) *cobra.Command {
+	var output OutputInputs
+	cmd := &cobra.Command{
+		Use:   "get <name>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Show an OIDC scope",
+		Long:  "Show an OIDC scope with its claims. The scope is printed as YAML unless another format is requested.",
+		Example: `asgardeo oidc-scopes get profile
+  asgardeo oidc-scopes get profile --output json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.validate(); err != nil {
+				return err
+			}
+			scope, err := core.ResolveOIDCScope(cmd.Context(), cli, args[0])
+			if err != nil {
+				return err
+			}
+			if !output.isRequested() {
+				output.Format = outputYAML
+			}
+			return output.render(cmd.OutOrStdout(), oidcScopeView{scope: scope})
+		},
+	}
+	output.register(cmd)
+	return cmd
+}
+
+func createOIDCScopeCmd(cli *core.CLI) *cobra.Command {
+	var inputs core.OIDCScopeCreateInputs
+	var output OutputInputs
+	cmd := &cobra.Command{
+		Use:     "create <name>",
+		Aliases: []string{"c"},
+		Args:    cobra.ExactArgs(1),
+		Short:   "Create an OIDC scope",
+		Long: `Create an OIDC scope releasing the given claims. The claims are checked against the OIDC
+dialect, see 'asgardeo claims mappings list oidc'.`,
+		Example: `asgardeo oidc-scopes create employment --display-name Employment --claims department,employee_id`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.validate(); err != nil {
+				return err
+			}
+			inputs.Name = args[0]
+			scope, err := core.CreateOIDCScope(cmd.Context(), cli, inputs)
+			if err != nil {
+				return err
+			}
+			if output.isRequested() {
+				return output.render(cmd.OutOrStdout(), oidcScopeView{scope: scope})
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "OIDC scope %q created successfully.\n", scope.Name)
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&inputs.DisplayName, "display-name", "", "Display name of the scope (defaults to the scope name)")
+	cmd.Flags().StringVar(&inputs.Description, "description", "", "Description of the scope")
+	cmd.Flags().StringSliceVar(&inputs.Claims, "claims", nil, "Claims of the OIDC dialect released with the scope (comma separated)")
+	output.register(cmd)
+	return cmd
+}
+
+func updateOIDCScopeCmd(cli *core.CLI) *cobra.Command {
+	var inputs core.OIDCScopeUpdateInputs
+	var displayName, description string
+	var claims []string
+	cmd := &cobra.Command{
+		Use:     "update <name>",
+		Aliases: []string{"u"},
+		Args:    cobra.ExactArgs(1),
+		Short:   "Update an OIDC scope",
+		Long: `Update an OIDC scope. Only the given settings are changed, everything else is kept as is.
+--claims replaces the claims of the scope, while --add-claim and --remove-claim change them.`,
+		Example: `asgardeo oidc-scopes update employment --add-claim manager --remove-claim employee_id
+  asgardeo oidc-scopes update employment --claims department,manager --description "Employment details"`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			flags := cmd.Flags()
+			if flags.Changed("display-name") {
+				inputs.DisplayName = &displayName
+			}
+			if flags.Changed("description") {
+				inputs.Description = &description
+			}
+			if flags.Changed("claims") {
+				inputs.Claims = &claims
+			}
+			scope, err := core.UpdateOIDCScope(cmd.Context(), cli, args[0], inputs)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "OIDC scope %q updated successfully.\n", scope.Name)
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&displayName, "display-name", "", "Display name of the scope")
+	cmd.Flags().StringVar(&description, "description", "", "Description of the scope")
+	cmd.Flags().StringSliceVar(&claims, "claims", nil, "Claims released with the scope, replacing the current ones (comma separated)")
+	cmd.Flags().StringSliceVar(&inputs.AddClaims, "add-claim", nil, "Claim to release with the scope (repeatable)")
+	cmd.Flags().StringSliceVar(&inputs.RemoveClaims, "remove-claim", nil, "Claim to stop releasing with the scope (repeatable)")
+	return cmd
+}
+
+func deleteOIDCScopeCmd(cli *core.CLI) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "delete <name>",
+		Aliases: []string{"rm"},
+		Args:    cobra.ExactArgs(1),
+		Short:   "Delete an OIDC scope",
+		Example: `asgardeo oidc-scopes delete employment`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			scope, err := core.DeleteOIDCScope(cmd.Context(), cli, args[0])
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "OIDC scope %q deleted successfully.\n", scope.Name)
+			return nil
+		},
+	}
+	return cmd
+}
+
+type oidcScopeListView struct {
+	scopes []models.OIDCScope
+}
+
+func (v oidcScopeListView) Columns() []string {
+	return []string{"name", "displayName", "claims"}
+}
+
+func (v oidcScopeListView) Rows() [][]string {
+	var rows [][]string
+	for _, scope := range v.scopes {
+		rows = append(rows, []string{scope.Name, scope.DisplayName, strings.Join(scope.Claims, ",")})
+	}
+	return rows
+}
+
+func (v oidcScopeListView) Names() []string {
+	var names []string
+	for _, scope := range v.scopes {
+		names = append(names, scope.Name)
+	}
+	return names
+}
+
+func (v oidcScopeListView) Data() interface{} {
+	return v.scopes
+}
+
+type oidcScopeView struct {
+	scope *models.OIDCScope
+}
+
+func (v oidcScopeView) Columns() []string {
+	return oidcScopeListView{}.Columns()
+}
+
+func (v oidcScopeView) Rows() [][]string {
+	return oidcScopeListView{scopes: []models.OIDCScope{*v.scope}}.Rows()
+}
+
+func (v oidcScopeView) Names() []string {
+	return []string{v.scope.Name}
+}
+
+func (v oidcScopeView) Data() interface{} {
+	return v.scope
+}
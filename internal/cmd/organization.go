@@ -0,0 +1,204 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/shashimalcse/asgardeo-cli/internal/core"
+	"github.com/shashimalcse/asgardeo-cli/internal/models"
+	"github.com/spf13/cobra"
+)
+
+func organizationsCmd(cli *core.CLI) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "orgs",
+		Aliases: []string{"organizations"},
+		Short:   "Manage organizations and switch between them",
+		Long: `Manage the organizations of the tenant and switch the other commands to one of them.
+
+The orgs commands always run against the root organization of the tenant. Once switched to an
+organization, every other command manages the resources of that organization until it is switched
+back with 'asgardeo orgs switch --root'.`,
+	}
+
+	cmd.AddCommand(listOrganizationsCmd(cli))
+	cmd.AddCommand(createOrganizationCmd(cli))
+	cmd.AddCommand(deleteOrganizationCmd(cli))
+	cmd.AddCommand(switchOrganizationCmd(cli))
+	return cmd
+}
+
+func listOrganizationsCmd(cli *core.CLI) *cobra.Command {
+	var output OutputInputs
+	var filter string
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Args:    cobra.NoArgs,
+		Short:   "List organizations",
+		Long:    "List the organizations of the tenant at every level. The organization the tenant is switched to is marked as current.",
+		Example: `asgardeo orgs list
+  asgardeo orgs list --filter "name co acme" --output json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.validate(); err != nil {
+				return err
+			}
+			organizations, err := cli.API.Organization.List(cmd.Context(), filter)
+			if err != nil {
+				return fmt.Errorf("failed to list organizations: %w", err)
+			}
+			current, err := core.CurrentOrganization(cli)
+			if err != nil {
+				return err
+			}
+			view := organizationListView{organizations: organizations}
+			if current != nil {
+				view.current = current.ID
+			}
+			return output.render(cmd.OutOrStdout(), view)
+		},
+	}
+	cmd.Flags().StringVar(&filter, "filter", "", "Filter of the organizations, such as \"name co acme\"")
+	output.register(cmd)
+	return cmd
+}
+
+func createOrganizationCmd(cli *core.CLI) *cobra.Command {
+	var inputs core.OrganizationCreateInputs
+	var output OutputInputs
+	cmd := &cobra.Command{
+		Use:     "create <name>",
+		Aliases: []string{"c"},
+		Args:    cobra.ExactArgs(1),
+		Short:   "Create an organization",
+		Long:    "Create an organization under the root organization, or under the given parent organization.",
+		Example: `asgardeo orgs create acme --description "Acme Corporation"
+  asgardeo orgs create acme-emea --parent acme`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.validate(); err != nil {
+				return err
+			}
+			inputs.Name = args[0]
+			organization, err := core.CreateOrganization(cmd.Context(), cli, inputs)
+			if err != nil {
+				return err
+			}
+			if output.isRequested() {
+				return output.render(cmd.OutOrStdout(), organizationView{organization: organization})
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Organization %q created successfully with ID %s.\n", organization.Name, organization.ID)
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&inputs.Description, "description", "", "Description of the organization")
+	cmd.Flags().StringVar(&inputs.Parent, "parent", "", "ID or name of the parent organization (defaults to the root organization)")
+	output.register(cmd)
+	return cmd
+}
+
+func deleteOrganizationCmd(cli *core.CLI) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "delete <org>",
+		Aliases: []string{"rm"},
+		Args:    cobra.ExactArgs(1),
+		Short:   "Delete an organization",
+		Long: `Delete an organization by its ID or name. Organizations with child organizations cannot be
+deleted. When the tenant is switched to the deleted organization, it is switched back to the root
+organization.`,
+		Example: `asgardeo orgs delete acme-emea`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			organization, err := core.DeleteOrganization(cmd.Context(), cli, args[0])
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Organization %q deleted successfully.\n", organization.Name)
+			return nil
+		},
+	}
+	return cmd
+}
+
+func switchOrganizationCmd(cli *core.CLI) *cobra.Command {
+	var root bool
+	cmd := &cobra.Command{
+		Use:   "switch [<org>]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Switch the commands to an organization",
+		Long: `Switch the commands to an organization, given by its ID or name, or back to the root organization.
+
+The access token of the tenant is exchanged for an access token of the organization, which is
+renewed the same way once it expires. The application used to log in must be shared with the
+organization.`,
+		Example: `asgardeo orgs switch acme
+  asgardeo orgs switch --root`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if root == (len(args) == 1) {
+				return fmt.Errorf("give either an organization or --root")
+			}
+			if root {
+				if err := core.SwitchToRootOrganization(cli); err != nil {
+					return fmt.Errorf("failed to switch to the root organization: %w", err)
+				}
+				fmt.Fprintln(cmd.OutOrStdout(), "Switched to the root organization.")
+				return nil
+			}
+			organization, err := core.SwitchOrganization(cmd.Context(), cli, args[0])
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Switched to organization %q (%s).\n", organization.Name, organization.ID)
+			return nil
+		},
+	}
+	cmd.Flags().BoolVar(&root, "root", false, "Switch back to the root organization")
+	return cmd
+}
+
+type organizationListView struct {
+	organizations []models.Organization
+	current       string
+}
+
+func (v organizationListView) Columns() []string {
+	return []string{"id", "name", "status", "current"}
+}
+
+func (v organizationListView) Rows() [][]string {
+	var rows [][]string
+	for _, organization := range v.organizations {
+		rows = append(rows, []string{organization.ID, organization.Name, organization.Status, strconv.FormatBool(organization.ID == v.current)})
+	}
+	return rows
+}
+
+func (v organizationListView) Names() []string {
+	var names []string
+	for _, organization := range v.organizations {
+		names = append(names, organization.Name)
+	}
+	return names
+}
+
+func (v organizationListView) Data() interface{} {
+	return v.organizations
+}
+
+type organizationView struct {
+	organization *models.Organization
+}
+
+func (v organizationView) Columns() []string {
+	return []string{"id", "name", "status"}
+}
+
+func (v organizationView) Rows() [][]string {
+	return [][]string{{v.organization.ID, v.organization.Name, v.organization.Status}}
+}
+
+func (v organizationView) Names() []string {
+	return []string{v.organization.Name}
+}
+
+func (v organizationView) Data() interface{} {
+	return v.organization
+}
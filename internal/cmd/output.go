@@ -0,0 +1,220 @@
+package cmd
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"text/template"
+
+	"github.com/itchyny/gojq"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	outputJSON  = "json"
+	outputYAML  = "yaml"
+	outputTable = "table"
+	outputCSV   = "csv"
+	outputName  = "name"
+)
+
+var outputFormats = []string{outputJSON, outputYAML, outputTable, outputCSV, outputName}
+
+// renderable is implemented by every resource view that can be printed by the output layer.
+type renderable interface {
+	// Columns returns the header of the table and csv formats.
+	Columns() []string
+	// Rows returns one row per resource, matching the columns.
+	Rows() [][]string
+	// Names returns the name of each resource, used by the name format.
+	Names() []string
+	// Data returns the value marshalled by the json and yaml formats and queried by --jq and --go-template.
+	Data() interface{}
+}
+
+// OutputInputs holds the output flags shared by every read command.
+type OutputInputs struct {
+	Format   string
+	JQ       string
+	Template string
+}
+
+func (o *OutputInputs) register(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&o.Format, "output", "o", "", "Output format: "+strings.Join(outputFormats, "|"))
+	cmd.Flags().StringVar(&o.JQ, "jq", "", "Filter the JSON output using a jq expression")
+	cmd.Flags().StringVar(&o.Template, "go-template", "", "Format the JSON output using a Go template")
+	cmd.MarkFlagsMutuallyExclusive("jq", "go-template")
+	cmd.MarkFlagsMutuallyExclusive("output", "jq")
+	cmd.MarkFlagsMutuallyExclusive("output", "go-template")
+}
+
+func (o *OutputInputs) validate() error {
+	if o.Format == "" {
+		return nil
+	}
+	for _, format := range outputFormats {
+		if o.Format == format {
+			return nil
+		}
+	}
+	return fmt.Errorf("unsupported output format %q, supported formats are %s", o.Format, strings.Join(outputFormats, ", "))
+}
+
+// isRequested reports whether any output format, jq expression or template is requested.
+func (o *OutputInputs) isRequested() bool {
+	return o.Format != "" || o.JQ != "" || o.Template != ""
+}
+
+// IsInteractive reports whether the interactive view should be used instead of the output layer,
+// which is the case only when stdout is a terminal and no output format is requested.
+func (o *OutputInputs) IsInteractive() bool {
+	return !o.isRequested() && term.IsTerminal(int(os.Stdout.Fd()))
+}
+
+// render prints the view in the requested format. Table is used when no format is requested.
+func (o *OutputInputs) render(w io.Writer, view renderable) error {
+	if err := o.validate(); err != nil {
+		return err
+	}
+	switch {
+	case o.JQ != "":
+		return renderJQ(w, view.Data(), o.JQ)
+	case o.Template != "":
+		return renderTemplate(w, view.Data(), o.Template)
+	}
+	switch o.Format {
+	case outputJSON:
+		return renderJSON(w, view.Data())
+	case outputYAML:
+		return renderYAML(w, view.Data())
+	case outputCSV:
+		return renderCSV(w, view.Columns(), view.Rows())
+	case outputName:
+		for _, name := range view.Names() {
+			if _, err := fmt.Fprintln(w, name); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return renderTable(w, view.Columns(), view.Rows())
+	}
+}
+
+func renderJSON(w io.Writer, data interface{}) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(data)
+}
+
+// renderYAML prints the data as YAML. The data is converted through JSON first so that the
+// field names follow the json tags of the models.
+func renderYAML(w io.Writer, data interface{}) error {
+	generic, err := toGeneric(data)
+	if err != nil {
+		return err
+	}
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(generic); err != nil {
+		return fmt.Errorf("failed to encode yaml output: %w", err)
+	}
+	return encoder.Close()
+}
+
+func renderTable(w io.Writer, columns []string, rows [][]string) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+	header := make([]string, len(columns))
+	for i, column := range columns {
+		header[i] = strings.ToUpper(column)
+	}
+	if _, err := fmt.Fprintln(tw, strings.Join(header, "\t")); err != nil {
+		return err
+	}
+	for _, row := range rows {
+		if _, err := fmt.Fprintln(tw, strings.Join(row, "\t")); err != nil {
+			return err
+		}
+	}
+	return tw.Flush()
+}
+
+func renderCSV(w io.Writer, columns []string, rows [][]string) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(columns); err != nil {
+		return err
+	}
+	if err := cw.WriteAll(rows); err != nil {
+		return fmt.Errorf("failed to write csv output: %w", err)
+	}
+	return nil
+}
+
+// renderJQ prints every result of the jq query. Strings are printed raw, other values as JSON.
+func renderJQ(w io.Writer, data interface{}, expression string) error {
+	query, err := gojq.Parse(expression)
+	if err != nil {
+		return fmt.Errorf("invalid jq expression: %w", err)
+	}
+	generic, err := toGeneric(data)
+	if err != nil {
+		return err
+	}
+	iter := query.Run(generic)
+	for {
+		v, ok := iter.Next()
+		if !ok {
+			return nil
+		}
+		if err, ok := v.(error); ok {
+			return fmt.Errorf("failed to evaluate jq expression: %w", err)
+		}
+		if s, ok := v.(string); ok {
+			if _, err := fmt.Fprintln(w, s); err != nil {
+				return err
+			}
+			continue
+		}
+		out, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintln(w, string(out)); err != nil {
+			return err
+		}
+	}
+}
+
+func renderTemplate(w io.Writer, data interface{}, text string) error {
+	tmpl, err := template.New("output").Option("missingkey=zero").Parse(text)
+	if err != nil {
+		return fmt.Errorf("invalid template: %w", err)
+	}
+	generic, err := toGeneric(data)
+	if err != nil {
+		return err
+	}
+	if err := tmpl.Execute(w, generic); err != nil {
+		return fmt.Errorf("failed to execute template: %w", err)
+	}
+	return nil
+}
+
+// toGeneric converts the data into maps and slices keyed by the json field names.
+func toGeneric(data interface{}) (interface{}, error) {
+	buffer, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal output: %w", err)
+	}
+	var generic interface{}
+	if err := json.Unmarshal(buffer, &generic); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal output: %w", err)
+	}
+	return generic, nil
+}
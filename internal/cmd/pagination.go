@@ -0,0 +1,55 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/shashimalcse/asgardeo-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+const defaultListLimit = 100
+
+// PageInputs holds the paging flags shared by every list command.
+type PageInputs struct {
+	Limit    int
+	All      bool
+	PageSize int
+}
+
+func (p *PageInputs) register(cmd *cobra.Command) {
+	cmd.Flags().IntVar(&p.Limit, "limit", defaultListLimit, "Maximum number of results to list")
+	cmd.Flags().BoolVar(&p.All, "all", false, "List every result, fetching all the pages")
+	cmd.Flags().IntVar(&p.PageSize, "page-size", api.DefaultPageSize, "Number of results to fetch per request")
+	cmd.MarkFlagsMutuallyExclusive("limit", "all")
+}
+
+func (p *PageInputs) validate() error {
+	if p.Limit <= 0 {
+		return fmt.Errorf("--limit must be greater than zero, use --all to list every result")
+	}
+	if p.PageSize <= 0 {
+		return fmt.Errorf("--page-size must be greater than zero")
+	}
+	return nil
+}
+
+// limit returns the maximum number of results to list, or zero for every result.
+func (p *PageInputs) limit() int {
+	if p.All {
+		return 0
+	}
+	return p.Limit
+}
+
+// warnTruncated tells the user when the listed results are not all the results on the server.
+func warnTruncated(w io.Writer, listed, total int, hasMore bool, resource string) {
+	if !hasMore && total <= listed {
+		return
+	}
+	if total > listed {
+		fmt.Fprintf(w, "Showing %d of %d %s. Use --all or --limit to list more.\n", listed, total, resource)
+		return
+	}
+	fmt.Fprintf(w, "Showing the first %d %s. Use --all or --limit to list more.\n", listed, resource)
+}
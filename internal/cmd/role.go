@@ -0,0 +1,345 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/shashimalcse/asgardeo-cli/internal/api"
+	"github.com/shashimalcse/asgardeo-cli/internal/core"
+	"github.com/shashimalcse/asgardeo-cli/internal/models"
+	"github.com/spf13/cobra"
+)
+
+func rolesCmd(cli *core.CLI) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "roles",
+		Short: "Manage roles, their permissions and assignments",
+	}
+
+	cmd.AddCommand(listRolesCmd(cli))
+	cmd.AddCommand(getRoleCmd(cli))
+	cmd.AddCommand(createRoleCmd(cli))
+	cmd.AddCommand(deleteRoleCmd(cli))
+	cmd.AddCommand(rolePermissionsCmd(cli))
+	cmd.AddCommand(assignRoleCmd(cli, true))
+	cmd.AddCommand(assignRoleCmd(cli, false))
+	return cmd
+}
+
+func listRolesCmd(cli *core.CLI) *cobra.Command {
+	var output OutputInputs
+	var page PageInputs
+	var query api.SCIMQuery
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Args:    cobra.NoArgs,
+		Short:   "List roles",
+		Example: `asgardeo roles list
+  asgardeo roles list --filter 'audience.type eq application'
+  asgardeo roles list --all --output csv`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.validate(); err != nil {
+				return err
+			}
+			if err := page.validate(); err != nil {
+				return err
+			}
+			paginator := cli.API.Role.Paginate(query, page.PageSize)
+			roles, err := paginator.All(cmd.Context(), page.limit())
+			if err != nil {
+				return fmt.Errorf("failed to list roles: %w", err)
+			}
+			list := &models.RoleV2List{
+				TotalResults: max(paginator.TotalResults(), len(roles)),
+				StartIndex:   1,
+				ItemsPerPage: len(roles),
+				Resources:    roles,
+			}
+			if err := output.render(cmd.OutOrStdout(), roleListView{list: list}); err != nil {
+				return err
+			}
+			warnTruncated(cmd.ErrOrStderr(), len(roles), list.TotalResults, paginator.HasNext(), "roles")
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&query.Filter, "filter", "", "SCIM filter, for example 'displayName sw loan'")
+	output.register(cmd)
+	page.register(cmd)
+	return cmd
+}
+
+func getRoleCmd(cli *core.CLI) *cobra.Command {
+	var output OutputInputs
+	cmd := &cobra.Command{
+		Use:   "get <id|name>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Show a role",
+		Long:  "Show a role with its audience, permissions, users and groups. The role is printed as YAML unless another format is requested.",
+		Example: `asgardeo roles get loan-manager
+  asgardeo roles get loan-manager --output json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.validate(); err != nil {
+				return err
+			}
+			role, err := core.ResolveRole(cmd.Context(), cli, args[0])
+			if err != nil {
+				return err
+			}
+			if !output.isRequested() {
+				output.Format = outputYAML
+			}
+			return output.render(cmd.OutOrStdout(), roleView{role: role})
+		},
+	}
+	output.register(cmd)
+	return cmd
+}
+
+func createRoleCmd(cli *core.CLI) *cobra.Command {
+	var inputs core.RoleCreateInputs
+	var output OutputInputs
+	cmd := &cobra.Command{
+		Use:     "create <name>",
+		Aliases: []string{"c"},
+		Args:    cobra.ExactArgs(1),
+		Short:   "Create a role",
+		Long: `Create a role of the organization, or of an application with --audience application:<app>.
+Application roles can only be created for applications that allow application roles.`,
+		Example: `asgardeo roles create loan-manager
+  asgardeo roles create loan-manager --audience application:"Loan Portal"
+  asgardeo roles create loan-manager --permission https://api.bank.com/loans:read_loans --permission https://api.bank.com/loans:approve_loans`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.validate(); err != nil {
+				return err
+			}
+			inputs.Name = args[0]
+			role, err := core.CreateRole(cmd.Context(), cli, inputs)
+			if err != nil {
+				return err
+			}
+			if output.isRequested() {
+				return output.render(cmd.OutOrStdout(), roleView{role: role})
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Role %q created successfully with ID %s.\n", role.DisplayName, role.ID)
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&inputs.Audience, "audience", core.RoleAudienceOrganization, "Audience of the role: organization or application:<app>")
+	cmd.Flags().StringSliceVar(&inputs.Permissions, "permission", nil, "Scope granted by the role, as <api-identifier>:<scope> (repeatable)")
+	output.register(cmd)
+	return cmd
+}
+
+func deleteRoleCmd(cli *core.CLI) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "delete <id|name>",
+		Aliases: []string{"rm"},
+		Args:    cobra.ExactArgs(1),
+		Short:   "Delete a role",
+		Example: `asgardeo roles delete loan-manager`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			role, err := core.DeleteRole(cmd.Context(), cli, args[0])
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Role %q deleted successfully.\n", role.DisplayName)
+			return nil
+		},
+	}
+	return cmd
+}
+
+func rolePermissionsCmd(cli *core.CLI) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "permissions",
+		Short: "Manage the api resource scopes granted by a role",
+	}
+
+	cmd.AddCommand(listRolePermissionsCmd(cli))
+	cmd.AddCommand(addRolePermissionsCmd(cli))
+	cmd.AddCommand(removeRolePermissionsCmd(cli))
+	return cmd
+}
+
+func listRolePermissionsCmd(cli *core.CLI) *cobra.Command {
+	var output OutputInputs
+	cmd := &cobra.Command{
+		Use:     "list <role>",
+		Aliases: []string{"ls"},
+		Args:    cobra.ExactArgs(1),
+		Short:   "List the scopes granted by a role",
+		Example: `asgardeo roles permissions list loan-manager`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.validate(); err != nil {
+				return err
+			}
+			role, err := core.ResolveRole(cmd.Context(), cli, args[0])
+			if err != nil {
+				return err
+			}
+			return output.render(cmd.OutOrStdout(), rolePermissionsView{permissions: role.Permissions})
+		},
+	}
+	output.register(cmd)
+	return cmd
+}
+
+func addRolePermissionsCmd(cli *core.CLI) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add <role> <api-identifier>:<scope>...",
+		Args:  cobra.MinimumNArgs(2),
+		Short: "Grant api resource scopes to a role",
+		Example: `asgardeo roles permissions add loan-manager https://api.bank.com/loans:read_loans
+  asgardeo roles permissions add loan-manager loans-api:read_loans loans-api:approve_loans`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			change, err := core.AddRolePermissions(cmd.Context(), cli, args[0], args[1:])
+			if err != nil {
+				return err
+			}
+			printRoleChange(cmd.OutOrStdout(), change, "already grants", "now grants")
+			return nil
+		},
+	}
+	return cmd
+}
+
+func removeRolePermissionsCmd(cli *core.CLI) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove <role> <api-identifier>:<scope>...",
+		Aliases: []string{"rm"},
+		Args:    cobra.MinimumNArgs(2),
+		Short:   "Revoke api resource scopes from a role",
+		Example: `asgardeo roles permissions remove loan-manager https://api.bank.com/loans:approve_loans`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			change, err := core.RemoveRolePermissions(cmd.Context(), cli, args[0], args[1:])
+			if err != nil {
+				return err
+			}
+			printRoleChange(cmd.OutOrStdout(), change, "does not grant", "no longer grants")
+			return nil
+		},
+	}
+	return cmd
+}
+
+func assignRoleCmd(cli *core.CLI, assign bool) *cobra.Command {
+	var assignees core.RoleAssignees
+	action, unchanged, changed := "unassign", "is not assigned to", "is no longer assigned to"
+	if assign {
+		action, unchanged, changed = "assign", "is already assigned to", "is now assigned to"
+	}
+	cmd := &cobra.Command{
+		Use:   action + " <role>",
+		Args:  cobra.ExactArgs(1),
+		Short: strings.ToUpper(action[:1]) + action[1:] + " a role to users and groups",
+		Example: fmt.Sprintf(`asgardeo roles %[1]s loan-manager --user alice --user bob
+  asgardeo roles %[1]s loan-manager --group loan-officers`, action),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			change, err := core.AssignRole(cmd.Context(), cli, args[0], assignees, assign)
+			if err != nil {
+				return err
+			}
+			printRoleChange(cmd.OutOrStdout(), change, unchanged, changed)
+			return nil
+		},
+	}
+	cmd.Flags().StringSliceVar(&assignees.Users, "user", nil, "ID or username of a user (repeatable)")
+	cmd.Flags().StringSliceVar(&assignees.Groups, "group", nil, "ID or name of a group (repeatable)")
+	return cmd
+}
+
+func printRoleChange(w io.Writer, change *core.RoleChange, unchanged, changed string) {
+	for _, item := range change.Unchanged {
+		fmt.Fprintf(w, "Role %q %s %s.\n", change.Role.DisplayName, unchanged, item)
+	}
+	if len(change.Changed) > 0 {
+		fmt.Fprintf(w, "Role %q %s %s.\n", change.Role.DisplayName, changed, strings.Join(change.Changed, ", "))
+	}
+}
+
+type roleListView struct {
+	list *models.RoleV2List
+}
+
+func (v roleListView) Columns() []string {
+	return []string{"id", "name", "audience"}
+}
+
+func (v roleListView) Rows() [][]string {
+	var rows [][]string
+	for _, role := range v.list.Resources {
+		rows = append(rows, []string{role.ID, role.DisplayName, core.RoleAudience(&role)})
+	}
+	return rows
+}
+
+func (v roleListView) Names() []string {
+	var names []string
+	for _, role := range v.list.Resources {
+		names = append(names, role.DisplayName)
+	}
+	return names
+}
+
+func (v roleListView) Data() interface{} {
+	return v.list
+}
+
+type roleView struct {
+	role *models.RoleV2
+}
+
+func (v roleView) Columns() []string {
+	return []string{"id", "name", "audience", "permissions", "users", "groups"}
+}
+
+func (v roleView) Rows() [][]string {
+	role := v.role
+	return [][]string{{
+		role.ID,
+		role.DisplayName,
+		core.RoleAudience(role),
+		strconv.Itoa(len(role.Permissions)),
+		strconv.Itoa(len(role.Users)),
+		strconv.Itoa(len(role.Groups)),
+	}}
+}
+
+func (v roleView) Names() []string {
+	return []string{v.role.DisplayName}
+}
+
+func (v roleView) Data() interface{} {
+	return v.role
+}
+
+type rolePermissionsView struct {
+	permissions []models.RolePermission
+}
+
+func (v rolePermissionsView) Columns() []string {
+	return []string{"scope", "displayName"}
+}
+
+func (v rolePermissionsView) Rows() [][]string {
+	var rows [][]string
+	for _, permission := range v.permissions {
+		rows = append(rows, []string{permission.Value, permission.Display})
+	}
+	return rows
+}
+
+func (v rolePermissionsView) Names() []string {
+	var names []string
+	for _, permission := range v.permissions {
+		names = append(names, permission.Value)
+	}
+	return names
+}
+
+func (v rolePermissionsView) Data() interface{} {
+	return v.permissions
+}
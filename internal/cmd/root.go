@@ -6,6 +6,7 @@ import (
 	"os"
 	"os/signal"
 	"path/filepath"
+	"strings"
 
 	"github.com/shashimalcse/asgardeo-cli/internal/config"
 	"github.com/shashimalcse/asgardeo-cli/internal/core"
@@ -64,6 +65,7 @@ func buildRootCmd(cli *core.CLI) *cobra.Command {
 			if !commandRequiresAuthentication(cmd.CommandPath()) {
 				return nil
 			}
+			cli.RootOrganization = commandRunsInRootOrganization(cmd.CommandPath())
 			if err := cli.SetupWithAuthentication(); err != nil {
 				cli.Logger.Error("Authentication setup failed", zap.Error(err))
 				return fmt.Errorf("authentication failed: %w", err)
@@ -71,6 +73,10 @@ func buildRootCmd(cli *core.CLI) *cobra.Command {
 			return nil
 		},
 	}
+	rootCommand.PersistentFlags().IntVar(&cli.Retry.MaxRetries, "max-retries", cli.Retry.MaxRetries,
+		"Number of times a request is retried after network errors, rate limits and server errors")
+	rootCommand.PersistentFlags().DurationVar(&cli.Retry.MaxDelay, "max-retry-delay", cli.Retry.MaxDelay,
+		"Maximum time to wait between retries, including the time asked by the server")
 	return rootCommand
 }
 
@@ -79,6 +85,15 @@ func addSubCommands(rootCmd *cobra.Command, cli *core.CLI) {
 	rootCmd.AddCommand(logoutCmd(cli))
 	rootCmd.AddCommand(applicationsCmd(cli))
 	rootCmd.AddCommand(apiResourceCmd(cli))
+	rootCmd.AddCommand(usersCmd(cli))
+	rootCmd.AddCommand(groupsCmd(cli))
+	rootCmd.AddCommand(rolesCmd(cli))
+	rootCmd.AddCommand(identityProvidersCmd(cli))
+	rootCmd.AddCommand(claimsCmd(cli))
+	rootCmd.AddCommand(oidcScopesCmd(cli))
+	rootCmd.AddCommand(organizationsCmd(cli))
+	rootCmd.AddCommand(applyCmd(cli))
+	rootCmd.AddCommand(exportCmd(cli))
 }
 
 func commandRequiresAuthentication(invokedCommandName string) bool {
@@ -89,6 +104,12 @@ func commandRequiresAuthentication(invokedCommandName string) bool {
 	return !commandsWithNoAuthRequired[invokedCommandName]
 }
 
+// commandRunsInRootOrganization reports whether the command manages the organizations of the
+// tenant, which is done from the root organization even when the tenant is switched to another.
+func commandRunsInRootOrganization(invokedCommandName string) bool {
+	return strings.HasPrefix(invokedCommandName+" ", "asgardeo orgs ")
+}
+
 func configLogger() (*zap.Logger, error) {
 	newConfig := zap.NewProductionConfig()
 	cwd, err := os.Getwd()
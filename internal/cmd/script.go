@@ -0,0 +1,140 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/shashimalcse/asgardeo-cli/internal/core"
+	"github.com/spf13/cobra"
+)
+
+func scriptCmd(cli *core.CLI) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "script",
+		Short: "Manage the conditional authentication script of an application",
+		Long: `Manage the conditional authentication (adaptive) script of the login flow of an application,
+so that it can be kept under version control.`,
+	}
+
+	cmd.AddCommand(pullScriptCmd(cli))
+	cmd.AddCommand(pushScriptCmd(cli))
+	cmd.AddCommand(diffScriptCmd(cli))
+	return cmd
+}
+
+func pullScriptCmd(cli *core.CLI) *cobra.Command {
+	var out string
+	cmd := &cobra.Command{
+		Use:   "pull <app>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Print the script of an application",
+		Example: `asgardeo apps script pull my-app > script.js
+  asgardeo apps script pull my-app --out script.js`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			application, script, err := core.GetScript(cmd.Context(), cli, args[0])
+			if err != nil {
+				return err
+			}
+			if script == "" {
+				return fmt.Errorf("application %s has no script", application.Name)
+			}
+			if out == "" {
+				_, err := io.WriteString(cmd.OutOrStdout(), withTrailingNewline(script))
+				return err
+			}
+			if err := os.WriteFile(out, []byte(withTrailingNewline(script)), 0o644); err != nil {
+				return fmt.Errorf("failed to write script: %w", err)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Script of application %q written to %s.\n", application.Name, out)
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&out, "out", "", "File to write the script to instead of the standard output")
+	return cmd
+}
+
+func pushScriptCmd(cli *core.CLI) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "push <app> <file>",
+		Args:  cobra.ExactArgs(2),
+		Short: "Replace the script of an application",
+		Long: `Replace the script of an application with the script of a file, or of the standard input when the
+file is -. The syntax of the script is checked before it is uploaded, and the steps of the login
+flow are kept.`,
+		Example: `asgardeo apps script push my-app script.js
+  cat script.js | asgardeo apps script push my-app -`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			script, err := readScript(cmd, args[1])
+			if err != nil {
+				return err
+			}
+			application, err := core.PushScript(cmd.Context(), cli, args[0], script)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Script of application %q updated successfully.\n", application.Name)
+			return nil
+		},
+	}
+	return cmd
+}
+
+func diffScriptCmd(cli *core.CLI) *cobra.Command {
+	var exitCode bool
+	cmd := &cobra.Command{
+		Use:   "diff <app> <file>",
+		Args:  cobra.ExactArgs(2),
+		Short: "Show the differences between the script of an application and a local file",
+		Long: `Show the differences between the script of an application and a local file in the unified
+format. The changes are the ones 'apps script push' would make.`,
+		Example: `asgardeo apps script diff my-app script.js
+  asgardeo apps script diff my-app script.js --exit-code`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			local, err := readScript(cmd, args[1])
+			if err != nil {
+				return err
+			}
+			application, remote, err := core.GetScript(cmd.Context(), cli, args[0])
+			if err != nil {
+				return err
+			}
+			diff := core.UnifiedDiff(remote, local, application.Name, args[1])
+			if diff == "" {
+				fmt.Fprintln(cmd.OutOrStdout(), "No differences.")
+				return nil
+			}
+			fmt.Fprint(cmd.OutOrStdout(), diff)
+			if exitCode {
+				return fmt.Errorf("the script of application %s differs from %s", application.Name, args[1])
+			}
+			return nil
+		},
+	}
+	cmd.Flags().BoolVar(&exitCode, "exit-code", false, "Exit with an error when the scripts differ")
+	return cmd
+}
+
+func readScript(cmd *cobra.Command, file string) (string, error) {
+	var r io.Reader = cmd.InOrStdin()
+	if file != "-" {
+		f, err := os.Open(file)
+		if err != nil {
+			return "", fmt.Errorf("failed to read script: %w", err)
+		}
+		defer f.Close()
+		r = f
+	}
+	buffer, err := io.ReadAll(r)
+	if err != nil {
+		return "", fmt.Errorf("failed to read script: %w", err)
+	}
+	return string(buffer), nil
+}
+
+func withTrailingNewline(text string) string {
+	if len(text) > 0 && text[len(text)-1] != '\n' {
+		return text + "\n"
+	}
+	return text
+}
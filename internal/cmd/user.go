@@ -0,0 +1,427 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/shashimalcse/asgardeo-cli/internal/api"
+	"github.com/shashimalcse/asgardeo-cli/internal/core"
+	"github.com/shashimalcse/asgardeo-cli/internal/models"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+func usersCmd(cli *core.CLI) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "users",
+		Short: "Manage users",
+	}
+
+	cmd.AddCommand(listUsersCmd(cli))
+	cmd.AddCommand(searchUsersCmd(cli))
+	cmd.AddCommand(getUserCmd(cli))
+	cmd.AddCommand(createUserCmd(cli))
+	cmd.AddCommand(updateUserCmd(cli))
+	cmd.AddCommand(deleteUserCmd(cli))
+	cmd.AddCommand(resetUserPasswordCmd(cli))
+	cmd.AddCommand(forceUserPasswordResetCmd(cli))
+	cmd.AddCommand(lockUserCmd(cli, true))
+	cmd.AddCommand(lockUserCmd(cli, false))
+	cmd.AddCommand(importUsersCmd(cli))
+	cmd.AddCommand(exportUsersCmd(cli))
+	return cmd
+}
+
+func registerSCIMQueryFlags(cmd *cobra.Command, query *api.SCIMQuery) {
+	cmd.Flags().StringSliceVar(&query.Attributes, "attributes", nil, "Attributes to return, for example userName,emails")
+	cmd.Flags().StringSliceVar(&query.ExcludedAttributes, "excluded-attributes", nil, "Attributes to leave out")
+	cmd.MarkFlagsMutuallyExclusive("attributes", "excluded-attributes")
+}
+
+func listUsersCmd(cli *core.CLI) *cobra.Command {
+	var output OutputInputs
+	var page PageInputs
+	var query api.SCIMQuery
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Args:    cobra.NoArgs,
+		Short:   "List users",
+		Example: `asgardeo users list
+  asgardeo users list --filter 'emails co example.com'
+  asgardeo users list --filter 'userName sw john' --attributes userName,emails --output json
+  asgardeo users list --all --output csv`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return listUsers(cmd, cli.API.User.Paginate(query, page.PageSize), &output, &page)
+		},
+	}
+	cmd.Flags().StringVar(&query.Filter, "filter", "", "SCIM filter, for example 'emails co example.com'")
+	registerSCIMQueryFlags(cmd, &query)
+	output.register(cmd)
+	page.register(cmd)
+	return cmd
+}
+
+func searchUsersCmd(cli *core.CLI) *cobra.Command {
+	var output OutputInputs
+	var page PageInputs
+	var query api.SCIMQuery
+	cmd := &cobra.Command{
+		Use:   "search <filter>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Search users with a SCIM filter",
+		Long:  "Search users with a SCIM filter. The search is sent in the request body, so filters of any length can be used.",
+		Example: `asgardeo users search 'name.givenName eq "John" and emails co example.com'
+  asgardeo users search 'groups eq admins' -o name`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			query.Filter = args[0]
+			return listUsers(cmd, cli.API.User.Search(query, page.PageSize), &output, &page)
+		},
+	}
+	registerSCIMQueryFlags(cmd, &query)
+	output.register(cmd)
+	page.register(cmd)
+	return cmd
+}
+
+func listUsers(cmd *cobra.Command, paginator *api.Paginator[models.User], output *OutputInputs, page *PageInputs) error {
+	if err := output.validate(); err != nil {
+		return err
+	}
+	if err := page.validate(); err != nil {
+		return err
+	}
+	users, err := paginator.All(cmd.Context(), page.limit())
+	if err != nil {
+		return fmt.Errorf("failed to list users: %w", err)
+	}
+	list := &models.UserList{
+		TotalResults: max(paginator.TotalResults(), len(users)),
+		StartIndex:   1,
+		ItemsPerPage: len(users),
+		Resources:    users,
+	}
+	if err := output.render(cmd.OutOrStdout(), userListView{list: list}); err != nil {
+		return err
+	}
+	warnTruncated(cmd.ErrOrStderr(), len(users), list.TotalResults, paginator.HasNext(), "users")
+	return nil
+}
+
+func getUserCmd(cli *core.CLI) *cobra.Command {
+	var output OutputInputs
+	var attributes []string
+	cmd := &cobra.Command{
+		Use:   "get <id|username>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Show a user",
+		Long:  "Show a user with its core and enterprise attributes. The user is printed as YAML unless another format is requested.",
+		Example: `asgardeo users get john@example.com
+  asgardeo users get 5c1b3d2e-0f0a-4f1e-9c3b-2a4d6e8f0a1b --output json
+  asgardeo users get john@example.com --attributes emails,groups`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.validate(); err != nil {
+				return err
+			}
+			user, err := core.ResolveUser(cmd.Context(), cli, args[0])
+			if err != nil {
+				return err
+			}
+			if len(attributes) > 0 {
+				if user, err = cli.API.User.Get(cmd.Context(), user.ID, attributes); err != nil {
+					return fmt.Errorf("failed to get user: %w", err)
+				}
+			}
+			if !output.isRequested() {
+				output.Format = outputYAML
+			}
+			return output.render(cmd.OutOrStdout(), userView{user: user})
+		},
+	}
+	cmd.Flags().StringSliceVar(&attributes, "attributes", nil, "Attributes to return, for example userName,emails")
+	output.register(cmd)
+	return cmd
+}
+
+func createUserCmd(cli *core.CLI) *cobra.Command {
+	var inputs core.UserCreateInputs
+	var passwordStdin bool
+	var output OutputInputs
+	cmd := &cobra.Command{
+		Use:     "create",
+		Aliases: []string{"c"},
+		Args:    cobra.NoArgs,
+		Short:   "Create a user",
+		Long:    "Create a user with a password, or ask the user to set a password through an email invitation.",
+		Example: `asgardeo users create --username john@example.com --email john@example.com --ask-password
+  echo "$PASSWORD" | asgardeo users create --username john --password-stdin --given-name John --family-name Doe
+  asgardeo users create --username jane --email jane@example.com --department Engineering --ask-password -o json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.validate(); err != nil {
+				return err
+			}
+			if !inputs.AskPassword {
+				password, err := readPassword(cmd, inputs.Password, passwordStdin)
+				if err != nil {
+					return err
+				}
+				inputs.Password = password
+			}
+			user, err := core.CreateUser(cmd.Context(), cli, inputs)
+			if err != nil {
+				return err
+			}
+			if output.isRequested() {
+				return output.render(cmd.OutOrStdout(), userView{user: user})
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "User %q created successfully with ID %s.\n", user.UserName, user.ID)
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&inputs.UserName, "username", "", "Username of the user")
+	cmd.Flags().StringVar(&inputs.Password, "password", "", "Password of the user (prefer --password-stdin)")
+	cmd.Flags().BoolVar(&passwordStdin, "password-stdin", false, "Read the password from stdin")
+	cmd.Flags().BoolVar(&inputs.AskPassword, "ask-password", false, "Email the user an invitation to set a password")
+	cmd.Flags().StringVar(&inputs.GivenName, "given-name", "", "Given name of the user")
+	cmd.Flags().StringVar(&inputs.FamilyName, "family-name", "", "Family name of the user")
+	cmd.Flags().StringSliceVar(&inputs.Emails, "email", nil, "Email address, the first one is primary (repeatable)")
+	cmd.Flags().StringSliceVar(&inputs.PhoneNumbers, "phone", nil, "Phone number, the first one is primary (repeatable)")
+	cmd.Flags().StringVar(&inputs.Department, "department", "", "Department of the user")
+	cmd.Flags().StringVar(&inputs.Organization, "organization", "", "Organization of the user")
+	cmd.Flags().StringVar(&inputs.EmployeeNumber, "employee-number", "", "Employee number of the user")
+	cmd.MarkFlagsMutuallyExclusive("password", "password-stdin", "ask-password")
+	_ = cmd.MarkFlagRequired("username")
+	output.register(cmd)
+	return cmd
+}
+
+func updateUserCmd(cli *core.CLI) *cobra.Command {
+	var inputs core.UserUpdateInputs
+	var givenName, familyName, displayName, department, organization, employeeNumber string
+	cmd := &cobra.Command{
+		Use:     "update <id|username>",
+		Aliases: []string{"u"},
+		Args:    cobra.ExactArgs(1),
+		Short:   "Update a user",
+		Long:    "Update a user. Only the given attributes are changed, everything else is kept as is.",
+		Example: `asgardeo users update john@example.com --given-name Johnny
+  asgardeo users update john@example.com --email john@example.com --email john.doe@example.com
+  asgardeo users update john@example.com --department Sales --employee-number 1042`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			flags := cmd.Flags()
+			if flags.Changed("given-name") {
+				inputs.GivenName = &givenName
+			}
+			if flags.Changed("family-name") {
+				inputs.FamilyName = &familyName
+			}
+			if flags.Changed("display-name") {
+				inputs.DisplayName = &displayName
+			}
+			if flags.Changed("department") {
+				inputs.Department = &department
+			}
+			if flags.Changed("organization") {
+				inputs.Organization = &organization
+			}
+			if flags.Changed("employee-number") {
+				inputs.EmployeeNumber = &employeeNumber
+			}
+			user, err := core.UpdateUser(cmd.Context(), cli, args[0], inputs)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "User %q updated successfully.\n", user.UserName)
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&givenName, "given-name", "", "Given name of the user")
+	cmd.Flags().StringVar(&familyName, "family-name", "", "Family name of the user")
+	cmd.Flags().StringVar(&displayName, "display-name", "", "Display name of the user")
+	cmd.Flags().StringSliceVar(&inputs.Emails, "email", nil, "Email address replacing the current ones, the first one is primary (repeatable)")
+	cmd.Flags().StringSliceVar(&inputs.PhoneNumbers, "phone", nil, "Phone number replacing the current ones, the first one is primary (repeatable)")
+	cmd.Flags().StringVar(&department, "department", "", "Department of the user")
+	cmd.Flags().StringVar(&organization, "organization", "", "Organization of the user")
+	cmd.Flags().StringVar(&employeeNumber, "employee-number", "", "Employee number of the user")
+	return cmd
+}
+
+func deleteUserCmd(cli *core.CLI) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "delete <id|username>",
+		Aliases: []string{"rm"},
+		Args:    cobra.ExactArgs(1),
+		Short:   "Delete a user",
+		Example: `asgardeo users delete john@example.com
+  asgardeo users rm 5c1b3d2e-0f0a-4f1e-9c3b-2a4d6e8f0a1b`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			user, err := core.DeleteUser(cmd.Context(), cli, args[0])
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "User %q deleted successfully.\n", user.UserName)
+			return nil
+		},
+	}
+	return cmd
+}
+
+func resetUserPasswordCmd(cli *core.CLI) *cobra.Command {
+	var password string
+	var passwordStdin bool
+	cmd := &cobra.Command{
+		Use:   "reset-password <id|username>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Set a new password for a user",
+		Long:  "Set a new password for a user. The password is prompted for when it is not given.",
+		Example: `asgardeo users reset-password john@example.com
+  echo "$PASSWORD" | asgardeo users reset-password john@example.com --password-stdin`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			password, err := readPassword(cmd, password, passwordStdin)
+			if err != nil {
+				return err
+			}
+			user, err := core.ResetUserPassword(cmd.Context(), cli, args[0], password)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Password of user %q reset successfully.\n", user.UserName)
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&password, "password", "", "New password of the user (prefer --password-stdin)")
+	cmd.Flags().BoolVar(&passwordStdin, "password-stdin", false, "Read the password from stdin")
+	cmd.MarkFlagsMutuallyExclusive("password", "password-stdin")
+	return cmd
+}
+
+func forceUserPasswordResetCmd(cli *core.CLI) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "force-password-reset <id|username>",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Make a user reset their password",
+		Long:    "Make a user reset their password. The user is emailed a link to set a new password.",
+		Example: `asgardeo users force-password-reset john@example.com`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			user, err := core.ForceUserPasswordReset(cmd.Context(), cli, args[0])
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "User %q must reset their password.\n", user.UserName)
+			return nil
+		},
+	}
+	return cmd
+}
+
+func lockUserCmd(cli *core.CLI, lock bool) *cobra.Command {
+	action, done := "unlock", "unlocked"
+	if lock {
+		action, done = "lock", "locked"
+	}
+	cmd := &cobra.Command{
+		Use:     action + " <id|username>",
+		Args:    cobra.ExactArgs(1),
+		Short:   strings.ToUpper(action[:1]) + action[1:] + " the account of a user",
+		Example: fmt.Sprintf("asgardeo users %s john@example.com", action),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			user, err := core.SetUserLocked(cmd.Context(), cli, args[0], lock)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "User %q %s successfully.\n", user.UserName, done)
+			return nil
+		},
+	}
+	return cmd
+}
+
+// readPassword returns the password of the flag, read from stdin, or prompted for in a terminal.
+func readPassword(cmd *cobra.Command, password string, fromStdin bool) (string, error) {
+	switch {
+	case password != "":
+		return password, nil
+	case fromStdin:
+		line, err := bufio.NewReader(cmd.InOrStdin()).ReadString('\n')
+		if err != nil && line == "" {
+			return "", fmt.Errorf("failed to read the password from stdin: %w", err)
+		}
+		return strings.TrimRight(line, "\r\n"), nil
+	case term.IsTerminal(int(os.Stdin.Fd())):
+		fmt.Fprint(cmd.ErrOrStderr(), "Password: ")
+		value, err := term.ReadPassword(int(os.Stdin.Fd()))
+		fmt.Fprintln(cmd.ErrOrStderr())
+		if err != nil {
+			return "", fmt.Errorf("failed to read the password: %w", err)
+		}
+		return string(value), nil
+	}
+	return "", fmt.Errorf("a password is required, use --password-stdin to read it from stdin")
+}
+
+type userListView struct {
+	list *models.UserList
+}
+
+func (v userListView) Columns() []string {
+	return []string{"id", "userName", "name", "email", "locked"}
+}
+
+func (v userListView) Rows() [][]string {
+	var rows [][]string
+	for _, user := range v.list.Resources {
+		rows = append(rows, userRow(&user))
+	}
+	return rows
+}
+
+func (v userListView) Names() []string {
+	var names []string
+	for _, user := range v.list.Resources {
+		names = append(names, user.UserName)
+	}
+	return names
+}
+
+func (v userListView) Data() interface{} {
+	return v.list
+}
+
+type userView struct {
+	user *models.User
+}
+
+func (v userView) Columns() []string {
+	return userListView{}.Columns()
+}
+
+func (v userView) Rows() [][]string {
+	return [][]string{userRow(v.user)}
+}
+
+func (v userView) Names() []string {
+	return []string{v.user.UserName}
+}
+
+func (v userView) Data() interface{} {
+	return v.user
+}
+
+func userRow(user *models.User) []string {
+	var name, email, locked string
+	if user.Name != nil {
+		name = strings.TrimSpace(user.Name.GivenName + " " + user.Name.FamilyName)
+	}
+	for _, value := range user.Emails {
+		if email == "" || value.Primary {
+			email = value.Value
+		}
+	}
+	if user.WSO2 != nil && user.WSO2.AccountLocked != nil {
+		locked = strconv.FormatBool(bool(*user.WSO2.AccountLocked))
+	}
+	return []string{user.ID, user.UserName, name, email, locked}
+}
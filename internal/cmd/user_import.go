@@ -0,0 +1,211 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/shashimalcse/asgardeo-cli/internal/api"
+	"github.com/shashimalcse/asgardeo-cli/internal/core"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+type UserImportInputs struct {
+	File        string
+	Mapping     map[string]string
+	AskPassword bool
+	ErrorReport string
+	DryRun      bool
+	core.UserImportOptions
+}
+
+func importUsersCmd(cli *core.CLI) *cobra.Command {
+	var inputs UserImportInputs
+	cmd := &cobra.Command{
+		Use:   "import",
+		Args:  cobra.NoArgs,
+		Short: "Import users from a CSV file",
+		Long: fmt.Sprintf(`Import users from a CSV file with a header row, creating them with SCIM bulk requests.
+
+Columns named after an attribute are imported into it, other columns are mapped with --map and the
+remaining ones are ignored. The attributes are %s.
+Multiple emails or phone numbers are separated by semicolons, the first one is primary.
+
+The imported users are recorded in a checkpoint file. When an import is interrupted or some rows
+fail, fix the file and run the same command with --resume to import the remaining rows. The failed
+rows are written to an error report.`, strings.Join(core.UserImportAttributes(), ", ")),
+		Example: `asgardeo users import --file users.csv --ask-password
+  asgardeo users import --file users.csv --map "E-mail=emails" --map "First Name=name.givenName" --dry-run
+  asgardeo users import --file users.csv --batch-size 200 --concurrency 8
+  asgardeo users import --file users.csv --resume`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if inputs.BatchSize <= 0 || inputs.Concurrency <= 0 {
+				return fmt.Errorf("--batch-size and --concurrency must be greater than zero")
+			}
+			f, err := os.Open(inputs.File)
+			if err != nil {
+				return fmt.Errorf("failed to open %s: %w", inputs.File, err)
+			}
+			defer f.Close()
+			userImport, err := core.ReadUserImport(f, inputs.Mapping, inputs.AskPassword)
+			if err != nil {
+				return fmt.Errorf("failed to read %s: %w", inputs.File, err)
+			}
+			w := cmd.OutOrStdout()
+			if len(userImport.Ignored) > 0 {
+				fmt.Fprintf(cmd.ErrOrStderr(), "Ignoring the columns %s. Use --map to import them.\n", strings.Join(userImport.Ignored, ", "))
+			}
+			if inputs.DryRun {
+				return printUserImportPlan(w, userImport)
+			}
+
+			if inputs.Checkpoint == "" {
+				inputs.Checkpoint = core.UserImportCheckpoint(inputs.File)
+			}
+			if inputs.ErrorReport == "" {
+				inputs.ErrorReport = inputs.File + ".errors.csv"
+			}
+			progress := newImportProgress(cmd.ErrOrStderr())
+			inputs.Progress = progress.update
+			result, importErr := core.ImportUsers(cmd.Context(), cli, inputs.File, userImport.Rows, inputs.UserImportOptions)
+			progress.done()
+			if result == nil {
+				return importErr
+			}
+			fmt.Fprintf(w, "Imported %d user(s), skipped %d already imported, %d failed.\n", result.Imported, result.Skipped, result.Failed)
+			if len(result.Failures) > 0 {
+				if err := core.WriteUserImportErrors(inputs.ErrorReport, result.Failures); err != nil {
+					return err
+				}
+				fmt.Fprintf(w, "The failed rows are written to %s.\n", inputs.ErrorReport)
+			} else if err := os.Remove(inputs.ErrorReport); err != nil && !errors.Is(err, os.ErrNotExist) {
+				return fmt.Errorf("failed to remove the error report of a previous import: %w", err)
+			}
+			if importErr != nil {
+				return fmt.Errorf("%w, run the command again with --resume to continue", importErr)
+			}
+			if result.Failed > 0 {
+				return fmt.Errorf("%d user(s) could not be imported, fix them and run the command again with --resume", result.Failed)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringVarP(&inputs.File, "file", "f", "", "CSV file of the users to import")
+	cmd.Flags().StringToStringVar(&inputs.Mapping, "map", nil, "Map a column to an attribute, as <column>=<attribute> (repeatable)")
+	cmd.Flags().BoolVar(&inputs.AskPassword, "ask-password", false, "Email the users without a password an invitation to set one")
+	cmd.Flags().IntVar(&inputs.BatchSize, "batch-size", core.DefaultImportBatchSize, "Number of users created by each bulk request")
+	cmd.Flags().IntVar(&inputs.Concurrency, "concurrency", core.DefaultImportConcurrency, "Number of bulk requests sent at the same time")
+	cmd.Flags().StringVar(&inputs.Checkpoint, "checkpoint", "", "File recording the imported users (default <file>.checkpoint)")
+	cmd.Flags().BoolVar(&inputs.Resume, "resume", false, "Resume a previous import, skipping the users recorded in the checkpoint")
+	cmd.Flags().StringVar(&inputs.ErrorReport, "error-report", "", "CSV file to write the failed rows to (default <file>.errors.csv)")
+	cmd.Flags().BoolVar(&inputs.DryRun, "dry-run", false, "Validate the file without importing the users")
+	_ = cmd.MarkFlagRequired("file")
+	return cmd
+}
+
+func printUserImportPlan(w io.Writer, userImport *core.UserImport) error {
+	invalid := 0
+	for _, row := range userImport.Rows {
+		if row.Err != nil {
+			invalid++
+			if row.UserName != "" {
+				fmt.Fprintf(w, "line %d (%s): %v\n", row.Line, row.UserName, row.Err)
+			} else {
+				fmt.Fprintf(w, "line %d: %v\n", row.Line, row.Err)
+			}
+		}
+	}
+	fmt.Fprintf(w, "Dry run: %d user(s) would be imported, %d row(s) are invalid.\n", len(userImport.Rows)-invalid, invalid)
+	return nil
+}
+
+// importProgress shows the progress of an import on a single line of a terminal, or as a line per
+// batch otherwise.
+type importProgress struct {
+	w        io.Writer
+	terminal bool
+	shown    bool
+}
+
+func newImportProgress(w io.Writer) *importProgress {
+	f, ok := w.(*os.File)
+	return &importProgress{w: w, terminal: ok && term.IsTerminal(int(f.Fd()))}
+}
+
+func (p *importProgress) update(progress core.UserImportProgress) {
+	processed := progress.Imported + progress.Skipped + progress.Failed
+	line := fmt.Sprintf("Importing users: %d/%d (%d failed)", processed, progress.Total, progress.Failed)
+	if p.terminal {
+		fmt.Fprintf(p.w, "\r%s", line)
+	} else {
+		fmt.Fprintln(p.w, line)
+	}
+	p.shown = true
+}
+
+func (p *importProgress) done() {
+	if p.terminal && p.shown {
+		fmt.Fprintln(p.w)
+	}
+}
+
+type UserExportInputs struct {
+	Format   string
+	Out      string
+	Filter   string
+	PageSize int
+}
+
+func exportUsersCmd(cli *core.CLI) *cobra.Command {
+	var inputs UserExportInputs
+	cmd := &cobra.Command{
+		Use:   "export",
+		Args:  cobra.NoArgs,
+		Short: "Export users to a CSV or JSON lines file",
+		Long: `Export users to a CSV or JSON lines file, fetching them page by page.
+
+CSV files have the columns read by asgardeo users import, so the users can be imported into
+another tenant. JSON lines files hold one SCIM user per line, with every attribute of the user.`,
+		Example: `asgardeo users export --out users.csv
+  asgardeo users export --format jsonl --out users.jsonl
+  asgardeo users export --filter 'emails co example.com' > example-users.csv`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if inputs.PageSize <= 0 {
+				return fmt.Errorf("--page-size must be greater than zero")
+			}
+			format := core.UserExportFormat(inputs.Format)
+			if !cmd.Flags().Changed("format") && strings.HasSuffix(inputs.Out, ".jsonl") {
+				format = core.UserExportJSONL
+			}
+			if format != core.UserExportCSV && format != core.UserExportJSONL {
+				return fmt.Errorf("unsupported format %q, use csv or jsonl", inputs.Format)
+			}
+			if inputs.Out == "" || inputs.Out == "-" {
+				_, err := core.ExportUsers(cmd.Context(), cli, cmd.OutOrStdout(), format, inputs.Filter, inputs.PageSize)
+				return err
+			}
+			f, err := os.Create(inputs.Out)
+			if err != nil {
+				return fmt.Errorf("failed to create %s: %w", inputs.Out, err)
+			}
+			defer f.Close()
+			exported, err := core.ExportUsers(cmd.Context(), cli, f, format, inputs.Filter, inputs.PageSize)
+			if err != nil {
+				return err
+			}
+			if err := f.Close(); err != nil {
+				return fmt.Errorf("failed to write %s: %w", inputs.Out, err)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Exported %d user(s) to %s.\n", exported, inputs.Out)
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&inputs.Format, "format", string(core.UserExportCSV), "Format of the file: csv or jsonl (default jsonl for .jsonl files)")
+	cmd.Flags().StringVar(&inputs.Out, "out", "", "File to write the users to (default stdout)")
+	cmd.Flags().StringVar(&inputs.Filter, "filter", "", "SCIM filter selecting the users to export")
+	cmd.Flags().IntVar(&inputs.PageSize, "page-size", api.DefaultPageSize, "Number of users to fetch per request")
+	return cmd
+}
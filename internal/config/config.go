@@ -17,7 +17,7 @@ var ErrNoAuthenticatedTenants = errors.New("not logged in to any tenant. Please
 type Config struct {
 	mu            sync.RWMutex
 	path          string
-	Server        string            `json:"server"`
+	Server        string            `json:"server,omitempty"`
 	DefaultTenant string            `json:"default_tenant"`
 	Tenants       map[string]Tenant `json:"tenants"`
 	initialized   bool
@@ -65,6 +65,16 @@ func (c *Config) Validate() error {
 	return nil
 }
 
+// GetServer returns the server used for new logins when no server is given explicitly
+func (c *Config) GetServer() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.Server == "" {
+		return DefaultServer
+	}
+	return c.Server
+}
+
 // IsLoggedInWithTenant checks if the user is logged in with a specific tenant
 func (c *Config) IsLoggedInWithTenant(tenantName string) bool {
 	c.mu.RLock()
@@ -91,6 +101,9 @@ func (c *Config) GetTenant(tenantName string) (Tenant, error) {
 
 // AddTenant adds a new tenant to the configuration
 func (c *Config) AddTenant(tenant Tenant) error {
+	if err := c.Initialize(); err != nil {
+		return err
+	}
 	c.mu.Lock()
 	defer c.mu.Unlock()
 	if c.DefaultTenant == "" {
@@ -102,6 +115,9 @@ func (c *Config) AddTenant(tenant Tenant) error {
 
 // RemoveTenant removes a tenant from the configuration
 func (c *Config) RemoveTenant(tenant string) error {
+	if err := c.Initialize(); err != nil {
+		return err
+	}
 	c.mu.Lock()
 	defer c.mu.Unlock()
 	delete(c.Tenants, tenant)
@@ -113,6 +129,9 @@ func (c *Config) RemoveTenant(tenant string) error {
 
 // SetDefaultTenant sets the default tenant
 func (c *Config) SetDefaultTenant(tenantName string) error {
+	if err := c.Initialize(); err != nil {
+		return err
+	}
 	c.mu.Lock()
 	defer c.mu.Unlock()
 	if _, ok := c.Tenants[tenantName]; !ok {
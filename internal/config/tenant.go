@@ -2,6 +2,9 @@ package config
 
 import (
 	"errors"
+	"fmt"
+	"net/url"
+	"strings"
 	"time"
 
 	"github.com/shashimalcse/asgardeo-cli/internal/keyring"
@@ -9,6 +12,17 @@ import (
 
 const accessTokenExpThreshold = 5 * time.Minute
 
+const (
+	// DefaultServer is the server used when a tenant is not bound to a specific server.
+	DefaultServer = "https://api.asgardeo.io"
+	// SuperTenant is the root tenant of a WSO2 Identity Server, which is served without the /t/{tenant} prefix.
+	SuperTenant = "carbon.super"
+	// AsgardeoUserstore is the primary user store of an Asgardeo organization.
+	AsgardeoUserstore = "DEFAULT"
+	// IdentityServerUserstore is the primary user store of a WSO2 Identity Server.
+	IdentityServerUserstore = "PRIMARY"
+)
+
 var ErrInvalidToken = errors.New("token is invalid")
 
 type Tenant struct {
@@ -17,6 +31,23 @@ type Tenant struct {
 	ExpiresIn    time.Time `json:"expires_in,omitempty"`
 	ClientID     string    `json:"client_id"`
 	RefreshToken string    `json:"refresh_token,omitempty"`
+	Server       string    `json:"server,omitempty"`
+	// Organization is the organization of the tenant the commands run against, if it was switched
+	// to one with `asgardeo orgs switch`.
+	Organization *Organization `json:"organization,omitempty"`
+}
+
+// Organization is an organization of a tenant, addressed with an access token obtained by
+// exchanging the access token of the tenant.
+type Organization struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	AccessToken string    `json:"access_token,omitempty"`
+	ExpiresIn   time.Time `json:"expires_in,omitempty"`
+}
+
+func (o *Organization) HasExpiredToken() bool {
+	return time.Now().Add(accessTokenExpThreshold).After(o.ExpiresIn)
 }
 
 func (t *Tenant) HasExpiredToken() bool {
@@ -32,6 +63,28 @@ func (t *Tenant) GetAccessToken() string {
 	return t.AccessToken
 }
 
+// GetOrganizationAccessToken returns the access token of the organization the tenant is switched to.
+func (t *Tenant) GetOrganizationAccessToken() string {
+	if t.Organization == nil {
+		return ""
+	}
+	accessToken, err := keyring.GetOrganizationAccessToken(t.Name)
+	if err == nil && accessToken != "" {
+		return accessToken
+	}
+
+	return t.Organization.AccessToken
+}
+
+func (t *Tenant) GetRefreshToken() string {
+	refreshToken, err := keyring.GetRefreshToken(t.Name)
+	if err == nil && refreshToken != "" {
+		return refreshToken
+	}
+
+	return t.RefreshToken
+}
+
 func (t *Tenant) CheckAuthenticationStatus() error {
 	accessToken := t.GetAccessToken()
 	if accessToken != "" {
@@ -39,3 +92,83 @@ func (t *Tenant) CheckAuthenticationStatus() error {
 	}
 	return ErrInvalidToken
 }
+
+// GetServer returns the server the tenant is bound to, falling back to the default server.
+func (t *Tenant) GetServer() string {
+	if t.Server == "" {
+		return DefaultServer
+	}
+	return t.Server
+}
+
+// PrimaryUserstore returns the primary user store of the server the tenant is bound to, which
+// is named differently by Asgardeo and WSO2 Identity Server.
+func (t *Tenant) PrimaryUserstore() string {
+	if IsAsgardeo(t.GetServer()) {
+		return AsgardeoUserstore
+	}
+	return IdentityServerUserstore
+}
+
+// BaseURL returns the tenant qualified base URL of the server the tenant is bound to.
+func (t *Tenant) BaseURL() string {
+	return TenantBaseURL(t.GetServer(), t.Name)
+}
+
+// TenantPath returns the path prefix used to address a tenant. The super tenant of
+// a WSO2 Identity Server is addressed from the root.
+func TenantPath(tenant string) string {
+	if tenant == "" || tenant == SuperTenant {
+		return ""
+	}
+	return "t/" + tenant
+}
+
+// OrganizationPath returns the path prefix used to address an organization of a tenant.
+func OrganizationPath(organizationID string) string {
+	return "o/" + organizationID
+}
+
+// TenantBaseURL joins the server and the path prefix of the tenant.
+func TenantBaseURL(server, tenant string) string {
+	server = strings.TrimSuffix(server, "/")
+	if tenantPath := TenantPath(tenant); tenantPath != "" {
+		return server + "/" + tenantPath
+	}
+	return server
+}
+
+// IsAsgardeo reports whether the server is Asgardeo rather than a WSO2 Identity Server.
+func IsAsgardeo(server string) bool {
+	u, err := url.Parse(server)
+	if err != nil {
+		return false
+	}
+	host := strings.ToLower(u.Hostname())
+	return host == "asgardeo.io" || strings.HasSuffix(host, ".asgardeo.io")
+}
+
+// NormalizeServer validates a server URL and returns it without a trailing slash.
+// An empty value resolves to the default server and a missing scheme defaults to https.
+func NormalizeServer(server string) (string, error) {
+	server = strings.TrimSpace(server)
+	if server == "" {
+		return DefaultServer, nil
+	}
+	if !strings.Contains(server, "://") {
+		server = "https://" + server
+	}
+	u, err := url.Parse(server)
+	if err != nil {
+		return "", fmt.Errorf("invalid server URL %q: %w", server, err)
+	}
+	if u.Scheme != "https" && u.Scheme != "http" {
+		return "", fmt.Errorf("invalid server URL %q: scheme must be http or https", server)
+	}
+	if u.Host == "" {
+		return "", fmt.Errorf("invalid server URL %q: host is missing", server)
+	}
+	u.RawQuery = ""
+	u.Fragment = ""
+	return strings.TrimSuffix(u.String(), "/"), nil
+}
@@ -0,0 +1,106 @@
+package config
+
+import "testing"
+
+func TestNormalizeServer(t *testing.T) {
+	tests := []struct {
+		name    string
+		server  string
+		want    string
+		wantErr bool
+	}{
+		{name: "empty uses the default server", server: "", want: DefaultServer},
+		{name: "blank uses the default server", server: "   ", want: DefaultServer},
+		{name: "missing scheme defaults to https", server: "api.eu.asgardeo.io", want: "https://api.eu.asgardeo.io"},
+		{name: "trailing slash is removed", server: "https://localhost:9443/", want: "https://localhost:9443"},
+		{name: "http is kept", server: "http://127.0.0.1:8080", want: "http://127.0.0.1:8080"},
+		{name: "path is kept", server: "https://example.com/is/", want: "https://example.com/is"},
+		{name: "query and fragment are dropped", server: "https://example.com?a=b#c", want: "https://example.com"},
+		{name: "unsupported scheme", server: "ftp://example.com", wantErr: true},
+		{name: "missing host", server: "https://", wantErr: true},
+		{name: "invalid URL", server: "https://exa mple.com:port", wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := NormalizeServer(test.server)
+			if test.wantErr {
+				if err == nil {
+					t.Fatalf("NormalizeServer(%q) = %q, want an error", test.server, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NormalizeServer(%q) returned an error: %v", test.server, err)
+			}
+			if got != test.want {
+				t.Errorf("NormalizeServer(%q) = %q, want %q", test.server, got, test.want)
+			}
+		})
+	}
+}
+
+func TestTenantPath(t *testing.T) {
+	tests := []struct {
+		tenant string
+		want   string
+	}{
+		{tenant: "acme", want: "t/acme"},
+		{tenant: "", want: ""},
+		{tenant: SuperTenant, want: ""},
+	}
+	for _, test := range tests {
+		if got := TenantPath(test.tenant); got != test.want {
+			t.Errorf("TenantPath(%q) = %q, want %q", test.tenant, got, test.want)
+		}
+	}
+}
+
+func TestTenantBaseURL(t *testing.T) {
+	tests := []struct {
+		server string
+		tenant string
+		want   string
+	}{
+		{server: DefaultServer, tenant: "acme", want: "https://api.asgardeo.io/t/acme"},
+		{server: "https://localhost:9443/", tenant: "acme", want: "https://localhost:9443/t/acme"},
+		{server: "https://localhost:9443", tenant: SuperTenant, want: "https://localhost:9443"},
+		{server: "https://localhost:9443/", tenant: SuperTenant, want: "https://localhost:9443"},
+		{server: "https://localhost:9443", tenant: "", want: "https://localhost:9443"},
+	}
+	for _, test := range tests {
+		if got := TenantBaseURL(test.server, test.tenant); got != test.want {
+			t.Errorf("TenantBaseURL(%q, %q) = %q, want %q", test.server, test.tenant, got, test.want)
+		}
+	}
+}
+
+func TestTenantGetServer(t *testing.T) {
+	tenant := Tenant{Name: "acme"}
+	if got := tenant.GetServer(); got != DefaultServer {
+		t.Errorf("GetServer() = %q, want the default server %q", got, DefaultServer)
+	}
+	tenant.Server = "https://localhost:9443"
+	if got := tenant.BaseURL(); got != "https://localhost:9443/t/acme" {
+		t.Errorf("BaseURL() = %q, want %q", got, "https://localhost:9443/t/acme")
+	}
+}
+
+func TestTenantPrimaryUserstore(t *testing.T) {
+	tests := []struct {
+		server string
+		want   string
+	}{
+		{server: "", want: AsgardeoUserstore},
+		{server: DefaultServer, want: AsgardeoUserstore},
+		{server: "https://api.eu.asgardeo.io", want: AsgardeoUserstore},
+		{server: "https://localhost:9443", want: IdentityServerUserstore},
+		{server: "https://is.example.com", want: IdentityServerUserstore},
+		{server: "https://asgardeo.io.example.com", want: IdentityServerUserstore},
+	}
+	for _, test := range tests {
+		tenant := Tenant{Name: "acme", Server: test.server}
+		if got := tenant.PrimaryUserstore(); got != test.want {
+			t.Errorf("PrimaryUserstore() with server %q = %q, want %q", test.server, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,188 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/shashimalcse/asgardeo-cli/internal/models"
+)
+
+// ResolveAPIResource finds a business api resource by its ID or by its identifier, and returns it
+// with its scopes.
+func ResolveAPIResource(ctx context.Context, cli *CLI, idOrIdentifier string) (*models.APIResource, error) {
+	list, err := cli.API.APIResource.List(ctx, "BUSINESS")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list api resources: %w", err)
+	}
+	for _, apiResource := range list.APIResources {
+		if apiResource.ID == idOrIdentifier || apiResource.Identifier == idOrIdentifier {
+			resolved, err := cli.API.APIResource.Get(ctx, apiResource.ID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get api resource %s: %w", apiResource.Identifier, err)
+			}
+			return resolved, nil
+		}
+	}
+	return nil, fmt.Errorf("api resource not found: %s", idOrIdentifier)
+}
+
+// APIResourceUpdateInputs holds the changes to apply to an api resource. Nil fields are left unchanged.
+type APIResourceUpdateInputs struct {
+	Name                  *string
+	Description           *string
+	RequiresAuthorization *bool
+}
+
+// UpdateAPIResource patches the api resource and returns the updated api resource.
+func UpdateAPIResource(ctx context.Context, cli *CLI, idOrIdentifier string, inputs APIResourceUpdateInputs) (*models.APIResource, error) {
+	if inputs.Name == nil && inputs.Description == nil && inputs.RequiresAuthorization == nil {
+		return nil, fmt.Errorf("no changes were given")
+	}
+	apiResource, err := ResolveAPIResource(ctx, cli, idOrIdentifier)
+	if err != nil {
+		return nil, err
+	}
+	patch := map[string]interface{}{}
+	if inputs.Name != nil {
+		if strings.TrimSpace(*inputs.Name) == "" {
+			return nil, fmt.Errorf("api resource name cannot be empty")
+		}
+		patch["name"] = *inputs.Name
+		apiResource.Name = *inputs.Name
+	}
+	if inputs.Description != nil {
+		patch["description"] = *inputs.Description
+		apiResource.Description = *inputs.Description
+	}
+	if inputs.RequiresAuthorization != nil {
+		patch["requiresAuthorization"] = *inputs.RequiresAuthorization
+		apiResource.RequiresAuthorization = *inputs.RequiresAuthorization
+	}
+	if err := cli.API.APIResource.Patch(ctx, apiResource.ID, patch); err != nil {
+		return nil, fmt.Errorf("failed to update api resource %s: %w", apiResource.Identifier, err)
+	}
+	return apiResource, nil
+}
+
+// AddAPIScopes adds the scopes to the api resource. Scope names are unique across the tenant, so
+// names already used by this or any other api resource are rejected before anything is sent.
+func AddAPIScopes(ctx context.Context, cli *CLI, idOrIdentifier string, scopes []models.Scope) (*models.APIResource, error) {
+	if len(scopes) == 0 {
+		return nil, fmt.Errorf("at least one scope is required")
+	}
+	apiResource, err := ResolveAPIResource(ctx, cli, idOrIdentifier)
+	if err != nil {
+		return nil, err
+	}
+	seen := map[string]bool{}
+	for i, scope := range scopes {
+		if strings.TrimSpace(scope.Name) == "" {
+			return nil, fmt.Errorf("scope name is required")
+		}
+		if seen[scope.Name] {
+			return nil, fmt.Errorf("scope %q is given more than once", scope.Name)
+		}
+		seen[scope.Name] = true
+		if hasScope(apiResource, scope.Name) {
+			return nil, fmt.Errorf("api resource %s already has scope %q", apiResource.Identifier, scope.Name)
+		}
+		if err := checkScopeNameAvailable(ctx, cli, scope.Name); err != nil {
+			return nil, err
+		}
+		if scope.DisplayName == "" {
+			scopes[i].DisplayName = scope.Name
+		}
+	}
+	if err := cli.API.APIResource.Patch(ctx, apiResource.ID, map[string]interface{}{"addedScopes": scopes}); err != nil {
+		return nil, fmt.Errorf("failed to add scopes to api resource %s: %w", apiResource.Identifier, err)
+	}
+	apiResource.Scopes = append(apiResource.Scopes, scopes...)
+	return apiResource, nil
+}
+
+// RemoveAPIScopes removes the scopes, given by name, from the api resource. The remaining scopes are
+// sent in one request, so either every scope is removed or none is.
+func RemoveAPIScopes(ctx context.Context, cli *CLI, idOrIdentifier string, names []string) (*models.APIResource, error) {
+	if len(names) == 0 {
+		return nil, fmt.Errorf("at least one scope is required")
+	}
+	apiResource, err := ResolveAPIResource(ctx, cli, idOrIdentifier)
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range names {
+		if !hasScope(apiResource, name) {
+			return nil, fmt.Errorf("api resource %s has no scope %q", apiResource.Identifier, name)
+		}
+	}
+	scopes := slices.DeleteFunc(slices.Clone(apiResource.Scopes), func(s models.Scope) bool { return slices.Contains(names, s.Name) })
+	if err := cli.API.APIResource.PutScopes(ctx, apiResource.ID, scopesOrEmpty(scopes)); err != nil {
+		return nil, fmt.Errorf("failed to remove scopes %s from api resource %s: %w", strings.Join(names, ", "), apiResource.Identifier, err)
+	}
+	apiResource.Scopes = scopes
+	return apiResource, nil
+}
+
+// APIScopeUpdateInputs holds the changes to apply to a scope. Nil fields are left unchanged.
+type APIScopeUpdateInputs struct {
+	Name        *string
+	DisplayName *string
+	Description *string
+}
+
+// UpdateAPIScope updates a scope of the api resource and returns the updated scope. The scopes are
+// replaced as a whole, as the server has no endpoint to update a single scope.
+func UpdateAPIScope(ctx context.Context, cli *CLI, idOrIdentifier, name string, inputs APIScopeUpdateInputs) (*models.APIResource, *models.Scope, error) {
+	if inputs.Name == nil && inputs.DisplayName == nil && inputs.Description == nil {
+		return nil, nil, fmt.Errorf("no changes were given")
+	}
+	apiResource, err := ResolveAPIResource(ctx, cli, idOrIdentifier)
+	if err != nil {
+		return nil, nil, err
+	}
+	index := slices.IndexFunc(apiResource.Scopes, func(s models.Scope) bool { return s.Name == name })
+	if index < 0 {
+		return nil, nil, fmt.Errorf("api resource %s has no scope %q", apiResource.Identifier, name)
+	}
+	scopes := slices.Clone(apiResource.Scopes)
+	scope := &scopes[index]
+	if inputs.Name != nil && *inputs.Name != scope.Name {
+		if strings.TrimSpace(*inputs.Name) == "" {
+			return nil, nil, fmt.Errorf("new scope name is required")
+		}
+		if err := checkScopeNameAvailable(ctx, cli, *inputs.Name); err != nil {
+			return nil, nil, err
+		}
+		scope.Name = *inputs.Name
+	}
+	if inputs.DisplayName != nil {
+		scope.DisplayName = *inputs.DisplayName
+	}
+	if inputs.Description != nil {
+		scope.Description = *inputs.Description
+	}
+	if err := cli.API.APIResource.PutScopes(ctx, apiResource.ID, scopesOrEmpty(scopes)); err != nil {
+		return nil, nil, fmt.Errorf("failed to update scope %s of api resource %s: %w", name, apiResource.Identifier, err)
+	}
+	apiResource.Scopes = scopes
+	return apiResource, scope, nil
+}
+
+// checkScopeNameAvailable returns an error when an api resource of the tenant already has a scope
+// with the name.
+func checkScopeNameAvailable(ctx context.Context, cli *CLI, name string) error {
+	scopes, err := cli.API.APIResource.ListScopes(ctx, fmt.Sprintf("name eq %s", name))
+	if err != nil {
+		return fmt.Errorf("failed to search scopes: %w", err)
+	}
+	if slices.ContainsFunc(scopes, func(s models.Scope) bool { return s.Name == name }) {
+		return fmt.Errorf("scope %q is already used by another api resource, scope names must be unique in the organization", name)
+	}
+	return nil
+}
+
+func hasScope(apiResource *models.APIResource, name string) bool {
+	return slices.ContainsFunc(apiResource.Scopes, func(s models.Scope) bool { return s.Name == name })
+}
@@ -0,0 +1,632 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/shashimalcse/asgardeo-cli/internal/models"
+)
+
+// ApplicationTemplate identifies the template an application is created from.
+type ApplicationTemplate string
+
+const (
+	TemplateSinglePage      ApplicationTemplate = "spa"
+	TemplateTraditionalOIDC ApplicationTemplate = "web-oidc"
+	TemplateTraditionalSAML ApplicationTemplate = "web-saml"
+	TemplateMobile          ApplicationTemplate = "mobile"
+	TemplateM2M             ApplicationTemplate = "m2m"
+)
+
+var ApplicationTemplates = []ApplicationTemplate{
+	TemplateSinglePage,
+	TemplateTraditionalOIDC,
+	TemplateTraditionalSAML,
+	TemplateMobile,
+	TemplateM2M,
+}
+
+// applicationTemplateIDs maps each template to the ID of the matching console template.
+var applicationTemplateIDs = map[ApplicationTemplate]string{
+	TemplateSinglePage:      "6a90e4b0-fbff-42d7-bfde-1efd98f07cd7",
+	TemplateTraditionalOIDC: "b9c5e11e-fc78-484b-9bec-015d247561b8",
+	TemplateTraditionalSAML: "776a73da-fd8e-490b-84ff-93009f8ede85",
+	TemplateMobile:          "mobile-application",
+	TemplateM2M:             "m2m-application",
+}
+
+// ApplicationCreateInputs holds the values used to build an application from a template.
+type ApplicationCreateInputs struct {
+	Template              ApplicationTemplate `json:"template"`
+	Name                  string              `json:"name"`
+	RedirectURLs          []string            `json:"redirectUrls,omitempty"`
+	AllowedOrigins        []string            `json:"allowedOrigins,omitempty"`
+	Issuer                string              `json:"issuer,omitempty"`
+	AssertionConsumerURLs []string            `json:"assertionConsumerUrls,omitempty"`
+}
+
+// ReadApplicationCreateInputs reads the inputs of an application from a JSON file.
+func ReadApplicationCreateInputs(path string) (ApplicationCreateInputs, error) {
+	var inputs ApplicationCreateInputs
+	buffer, err := os.ReadFile(path)
+	if err != nil {
+		return inputs, fmt.Errorf("failed to read application file: %w", err)
+	}
+	if err := json.Unmarshal(buffer, &inputs); err != nil {
+		return inputs, fmt.Errorf("failed to parse application file %s: %w", path, err)
+	}
+	return inputs, nil
+}
+
+// Validate checks that the fields required by the template are present.
+func (i *ApplicationCreateInputs) Validate() error {
+	if strings.TrimSpace(i.Name) == "" {
+		return fmt.Errorf("name is required")
+	}
+	switch i.Template {
+	case TemplateSinglePage, TemplateTraditionalOIDC, TemplateMobile:
+		if len(i.RedirectURLs) == 0 {
+			return fmt.Errorf("at least one redirect URL is required for the %s template", i.Template)
+		}
+	case TemplateTraditionalSAML:
+		if strings.TrimSpace(i.Issuer) == "" {
+			return fmt.Errorf("issuer is required for the %s template", i.Template)
+		}
+		if len(i.AssertionConsumerURLs) == 0 {
+			return fmt.Errorf("at least one assertion consumer URL is required for the %s template", i.Template)
+		}
+	case TemplateM2M:
+	case "":
+		return fmt.Errorf("template is required")
+	default:
+		return fmt.Errorf("unsupported template %q, supported templates are %s", i.Template, strings.Join(ApplicationTemplateNames(), ", "))
+	}
+	return nil
+}
+
+// BuildApplicationPayload builds the application creation payload of the template.
+func BuildApplicationPayload(inputs ApplicationCreateInputs) (map[string]interface{}, error) {
+	if err := inputs.Validate(); err != nil {
+		return nil, err
+	}
+	application := map[string]interface{}{
+		"name": inputs.Name,
+		"advancedConfigurations": map[string]interface{}{
+			"discoverableByEndUsers": false,
+			"skipLogoutConsent":      true,
+			"skipLoginConsent":       true,
+		},
+		"authenticationSequence": map[string]interface{}{
+			"type": "DEFAULT",
+			"steps": []interface{}{
+				map[string]interface{}{
+					"id": 1,
+					"options": []interface{}{
+						map[string]interface{}{
+							"idp":           "LOCAL",
+							"authenticator": "basic",
+						},
+					},
+				},
+			},
+		},
+		"associatedRoles": map[string]interface{}{
+			"allowedAudience": "APPLICATION",
+			"roles":           []string{},
+		},
+	}
+	usernameClaimConfiguration := map[string]interface{}{
+		"dialect": "LOCAL",
+		"requestedClaims": []interface{}{
+			map[string]interface{}{
+				"claim": map[string]interface{}{
+					"uri": "http://wso2.org/claims/username",
+				},
+			},
+		},
+	}
+
+	switch inputs.Template {
+	case TemplateSinglePage:
+		allowedOrigins := inputs.AllowedOrigins
+		if len(allowedOrigins) == 0 {
+			allowedOrigins = inputs.RedirectURLs
+		}
+		application["templateId"] = applicationTemplateIDs[TemplateSinglePage]
+		application["inboundProtocolConfiguration"] = map[string]interface{}{
+			"oidc": map[string]interface{}{
+				"accessToken": map[string]interface{}{
+					"applicationAccessTokenExpiryInSeconds": 3600,
+					"bindingType":                           "sso-session",
+					"revokeTokensWhenIDPSessionTerminated":  true,
+					"type":                                  "Default",
+					"userAccessTokenExpiryInSeconds":        3600,
+					"validateTokenBinding":                  false,
+				},
+				"allowedOrigins": allowedOrigins,
+				"callbackURLs":   inputs.RedirectURLs,
+				"grantTypes":     []string{"authorization_code", "refresh_token"},
+				"pkce": map[string]interface{}{
+					"mandatory":                      true,
+					"supportPlainTransformAlgorithm": false,
+				},
+				"publicClient": true,
+				"refreshToken": map[string]interface{}{
+					"expiryInSeconds":   86400,
+					"renewRefreshToken": true,
+				},
+			},
+		}
+		application["claimConfiguration"] = usernameClaimConfiguration
+	case TemplateTraditionalOIDC:
+		application["templateId"] = applicationTemplateIDs[TemplateTraditionalOIDC]
+		application["inboundProtocolConfiguration"] = map[string]interface{}{
+			"oidc": map[string]interface{}{
+				"allowedOrigins": nonNil(inputs.AllowedOrigins),
+				"callbackURLs":   inputs.RedirectURLs,
+				"grantTypes":     []string{"authorization_code"},
+				"publicClient":   false,
+				"refreshToken": map[string]interface{}{
+					"expiryInSeconds": 86400,
+				},
+			},
+		}
+		application["claimConfiguration"] = usernameClaimConfiguration
+	case TemplateTraditionalSAML:
+		application["templateId"] = applicationTemplateIDs[TemplateTraditionalSAML]
+		application["inboundProtocolConfiguration"] = map[string]interface{}{
+			"saml": map[string]interface{}{
+				"manualConfiguration": map[string]interface{}{
+					"issuer":                      inputs.Issuer,
+					"assertionConsumerUrls":       inputs.AssertionConsumerURLs,
+					"defaultAssertionConsumerUrl": inputs.AssertionConsumerURLs[0],
+					"attributeProfile": map[string]interface{}{
+						"alwaysIncludeAttributesInResponse": true,
+						"enabled":                           true,
+					},
+					"singleLogoutProfile": map[string]interface{}{
+						"enabled":      true,
+						"logoutMethod": "BACKCHANNEL",
+						"idpInitiatedSingleLogout": map[string]interface{}{
+							"enabled": false,
+						},
+					},
+				},
+			},
+		}
+	case TemplateMobile:
+		application["templateId"] = applicationTemplateIDs[TemplateMobile]
+		application["inboundProtocolConfiguration"] = map[string]interface{}{
+			"oidc": map[string]interface{}{
+				"allowedOrigins": nonNil(inputs.AllowedOrigins),
+				"callbackURLs":   inputs.RedirectURLs,
+				"grantTypes":     []string{"authorization_code", "refresh_token"},
+				"pkce": map[string]interface{}{
+					"mandatory":                      true,
+					"supportPlainTransformAlgorithm": false,
+				},
+				"publicClient": true,
+				"refreshToken": map[string]interface{}{
+					"expiryInSeconds":   86400,
+					"renewRefreshToken": true,
+				},
+			},
+		}
+		application["claimConfiguration"] = usernameClaimConfiguration
+	case TemplateM2M:
+		application["templateId"] = applicationTemplateIDs[TemplateM2M]
+		delete(application, "authenticationSequence")
+		application["inboundProtocolConfiguration"] = map[string]interface{}{
+			"oidc": map[string]interface{}{
+				"grantTypes":   []string{"client_credentials"},
+				"publicClient": false,
+			},
+		}
+	}
+	return application, nil
+}
+
+// CreateApplication creates an application from a template and returns the created application.
+func CreateApplication(ctx context.Context, cli *CLI, inputs ApplicationCreateInputs) (*models.Application, error) {
+	payload, err := BuildApplicationPayload(inputs)
+	if err != nil {
+		return nil, err
+	}
+	id, err := cli.API.Application.Create(ctx, payload)
+	if err != nil {
+		return nil, err
+	}
+	application, err := cli.API.Application.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("application %s was created but could not be retrieved: %w", id, err)
+	}
+	return application, nil
+}
+
+const (
+	ProtocolOIDC = "oidc"
+	ProtocolSAML = "saml"
+)
+
+// ApplicationCredentials holds what a client needs to integrate with an application.
+type ApplicationCredentials struct {
+	ApplicationID string     `json:"applicationId"`
+	Name          string     `json:"name"`
+	Protocol      string     `json:"protocol"`
+	ClientID      string     `json:"clientId,omitempty"`
+	ClientSecret  string     `json:"clientSecret,omitempty"`
+	Issuer        string     `json:"issuer,omitempty"`
+	Endpoints     []Endpoint `json:"endpoints"`
+}
+
+// Endpoint is a named server endpoint a client integrates with.
+type Endpoint struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+// GetApplicationCredentials fetches the inbound protocol configuration of an application and
+// resolves the endpoints of the tenant the application belongs to.
+func GetApplicationCredentials(ctx context.Context, cli *CLI, application *models.Application) (*ApplicationCredentials, error) {
+	tenant, err := cli.Config.GetTenant(cli.Tenant)
+	if err != nil {
+		return nil, err
+	}
+	baseURL := tenant.BaseURL()
+	credentials := &ApplicationCredentials{
+		ApplicationID: application.ID,
+		Name:          application.Name,
+	}
+	switch applicationProtocol(application) {
+	case ProtocolOIDC:
+		oidc, err := cli.API.Application.GetOIDCInboundProtocol(ctx, application.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the OIDC configuration of the application: %w", err)
+		}
+		credentials.Protocol = ProtocolOIDC
+		credentials.ClientID = oidc.ClientID
+		credentials.ClientSecret = oidc.ClientSecret
+		credentials.Issuer = baseURL + "/oauth2/token"
+		credentials.Endpoints = []Endpoint{
+			{Name: "discovery", URL: baseURL + "/oauth2/token/.well-known/openid-configuration"},
+			{Name: "authorize", URL: baseURL + "/oauth2/authorize"},
+			{Name: "token", URL: baseURL + "/oauth2/token"},
+			{Name: "userinfo", URL: baseURL + "/oauth2/userinfo"},
+			{Name: "jwks", URL: baseURL + "/oauth2/jwks"},
+			{Name: "logout", URL: baseURL + "/oidc/logout"},
+		}
+	case ProtocolSAML:
+		saml, err := cli.API.Application.GetSAMLInboundProtocol(ctx, application.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the SAML configuration of the application: %w", err)
+		}
+		credentials.Protocol = ProtocolSAML
+		credentials.Issuer = saml.Issuer
+		credentials.Endpoints = []Endpoint{
+			{Name: "sso", URL: baseURL + "/samlsso"},
+			{Name: "metadata", URL: baseURL + "/identity/metadata/saml2"},
+		}
+	default:
+		return nil, fmt.Errorf("application %s has no OIDC or SAML configuration", application.ID)
+	}
+	return credentials, nil
+}
+
+// EnvFile renders the credentials as the content of a .env file.
+func (c *ApplicationCredentials) EnvFile() string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("# %s\n", c.Name))
+	if c.ClientID != "" {
+		sb.WriteString(fmt.Sprintf("ASGARDEO_CLIENT_ID=%s\n", c.ClientID))
+	}
+	if c.ClientSecret != "" {
+		sb.WriteString(fmt.Sprintf("ASGARDEO_CLIENT_SECRET=%s\n", c.ClientSecret))
+	}
+	if c.Issuer != "" {
+		sb.WriteString(fmt.Sprintf("ASGARDEO_ISSUER=%s\n", c.Issuer))
+	}
+	for _, endpoint := range c.Endpoints {
+		sb.WriteString(fmt.Sprintf("ASGARDEO_%s_URL=%s\n", strings.ToUpper(endpoint.Name), endpoint.URL))
+	}
+	return sb.String()
+}
+
+// WriteEnvFile writes the credentials to a new .env file, readable only by the current user.
+func (c *ApplicationCredentials) WriteEnvFile(path string) error {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		if os.IsExist(err) {
+			return fmt.Errorf("failed to write the env file: %s already exists", path)
+		}
+		return fmt.Errorf("failed to write the env file: %w", err)
+	}
+	if _, err := file.WriteString(c.EnvFile()); err != nil {
+		_ = file.Close()
+		return fmt.Errorf("failed to write the env file: %w", err)
+	}
+	return file.Close()
+}
+
+// Summary renders the credentials for display to the user.
+func (c *ApplicationCredentials) Summary() string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("Application: %s\n", c.Name))
+	sb.WriteString(fmt.Sprintf("ID: %s\n", c.ApplicationID))
+	sb.WriteString(fmt.Sprintf("Protocol: %s\n", strings.ToUpper(c.Protocol)))
+	if c.ClientID != "" {
+		sb.WriteString(fmt.Sprintf("Client ID: %s\n", c.ClientID))
+	}
+	if c.ClientSecret != "" {
+		sb.WriteString(fmt.Sprintf("Client Secret: %s\n", c.ClientSecret))
+	}
+	if c.Issuer != "" {
+		sb.WriteString(fmt.Sprintf("Issuer: %s\n", c.Issuer))
+	}
+	if len(c.Endpoints) > 0 {
+		sb.WriteString("Endpoints:\n")
+		for _, endpoint := range c.Endpoints {
+			sb.WriteString(fmt.Sprintf("  %s: %s\n", endpoint.Name, endpoint.URL))
+		}
+	}
+	if c.ClientSecret != "" {
+		sb.WriteString("\nWarning: the client secret is shown only once. Store it securely now.\n")
+	}
+	return sb.String()
+}
+
+// ResolveApplication finds an application by its ID or by its name.
+func ResolveApplication(ctx context.Context, cli *CLI, idOrName string) (*models.Application, error) {
+	list, err := cli.API.Application.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list applications: %w", err)
+	}
+	var matches []models.Application
+	for _, application := range list.Applications {
+		if application.ID == idOrName {
+			return cli.API.Application.Get(ctx, application.ID)
+		}
+		if application.Name == idOrName {
+			matches = append(matches, application)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("application not found: %s", idOrName)
+	case 1:
+		return cli.API.Application.Get(ctx, matches[0].ID)
+	default:
+		return nil, fmt.Errorf("more than one application is named %q, use the application ID instead", idOrName)
+	}
+}
+
+// GetApplicationDetails returns the application with the configuration of its inbound protocol.
+// The client secret is removed unless it is explicitly requested.
+func GetApplicationDetails(ctx context.Context, cli *CLI, idOrName string, withSecret bool) (*models.Application, error) {
+	application, err := ResolveApplication(ctx, cli, idOrName)
+	if err != nil {
+		return nil, err
+	}
+	if err := loadInboundProtocolConfiguration(ctx, cli, application, withSecret); err != nil {
+		return nil, err
+	}
+	return application, nil
+}
+
+// loadInboundProtocolConfiguration sets the inbound protocol configuration of the application.
+func loadInboundProtocolConfiguration(ctx context.Context, cli *CLI, application *models.Application, withSecret bool) error {
+	switch applicationProtocol(application) {
+	case ProtocolOIDC:
+		oidc, err := cli.API.Application.GetOIDCInboundProtocol(ctx, application.ID)
+		if err != nil {
+			return fmt.Errorf("failed to get the OIDC configuration of the application: %w", err)
+		}
+		if !withSecret {
+			oidc.ClientSecret = ""
+		}
+		application.InboundProtocolConfiguration = &models.InboundProtocolConfiguration{OIDC: oidc}
+	case ProtocolSAML:
+		saml, err := cli.API.Application.GetSAMLInboundProtocol(ctx, application.ID)
+		if err != nil {
+			return fmt.Errorf("failed to get the SAML configuration of the application: %w", err)
+		}
+		application.InboundProtocolConfiguration = &models.InboundProtocolConfiguration{SAML: saml}
+	}
+	return nil
+}
+
+// ApplicationUpdateInputs holds the changes to apply to an application. Nil and empty fields are left unchanged.
+type ApplicationUpdateInputs struct {
+	Name                 *string
+	Description          *string
+	SkipLoginConsent     *bool
+	SkipLogoutConsent    *bool
+	AddCallbackURLs      []string
+	RemoveCallbackURLs   []string
+	AddAllowedOrigins    []string
+	RemoveAllowedOrigins []string
+	PKCEMandatory        *bool
+	AccessTokenExpiry    *int
+	RefreshTokenExpiry   *int
+}
+
+func (i *ApplicationUpdateInputs) hasApplicationChanges() bool {
+	return i.Name != nil || i.Description != nil || i.SkipLoginConsent != nil || i.SkipLogoutConsent != nil
+}
+
+func (i *ApplicationUpdateInputs) hasOIDCChanges() bool {
+	return len(i.AddCallbackURLs) > 0 || len(i.RemoveCallbackURLs) > 0 || len(i.AddAllowedOrigins) > 0 ||
+		len(i.RemoveAllowedOrigins) > 0 || i.PKCEMandatory != nil || i.AccessTokenExpiry != nil || i.RefreshTokenExpiry != nil
+}
+
+// UpdateApplication patches the application and, when needed, its OIDC configuration.
+func UpdateApplication(ctx context.Context, cli *CLI, idOrName string, inputs ApplicationUpdateInputs) (*models.Application, error) {
+	if !inputs.hasApplicationChanges() && !inputs.hasOIDCChanges() {
+		return nil, fmt.Errorf("no changes were given")
+	}
+	application, err := ResolveApplication(ctx, cli, idOrName)
+	if err != nil {
+		return nil, err
+	}
+	return updateApplication(ctx, cli, application, inputs)
+}
+
+func updateApplication(ctx context.Context, cli *CLI, application *models.Application, inputs ApplicationUpdateInputs) (*models.Application, error) {
+	if inputs.hasOIDCChanges() {
+		if applicationProtocol(application) != ProtocolOIDC {
+			return nil, fmt.Errorf("application %s is not an OIDC application", application.Name)
+		}
+		oidc, err := cli.API.Application.GetInboundProtocol(ctx, application.ID, ProtocolOIDC)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the OIDC configuration of the application: %w", err)
+		}
+		applyOIDCChanges(oidc, inputs)
+		if err := cli.API.Application.UpdateInboundProtocol(ctx, application.ID, ProtocolOIDC, oidc); err != nil {
+			return nil, fmt.Errorf("failed to update the OIDC configuration of the application: %w", err)
+		}
+	}
+	if inputs.hasApplicationChanges() {
+		patch := map[string]interface{}{}
+		if inputs.Name != nil {
+			patch["name"] = *inputs.Name
+		}
+		if inputs.Description != nil {
+			patch["description"] = *inputs.Description
+		}
+		advancedConfigurations := map[string]interface{}{}
+		if inputs.SkipLoginConsent != nil {
+			advancedConfigurations["skipLoginConsent"] = *inputs.SkipLoginConsent
+		}
+		if inputs.SkipLogoutConsent != nil {
+			advancedConfigurations["skipLogoutConsent"] = *inputs.SkipLogoutConsent
+		}
+		if len(advancedConfigurations) > 0 {
+			patch["advancedConfigurations"] = advancedConfigurations
+		}
+		if err := cli.API.Application.Patch(ctx, application.ID, patch); err != nil {
+			return nil, fmt.Errorf("failed to update the application: %w", err)
+		}
+	}
+	return cli.API.Application.Get(ctx, application.ID)
+}
+
+func applyOIDCChanges(oidc map[string]interface{}, inputs ApplicationUpdateInputs) {
+	if len(inputs.AddCallbackURLs) > 0 || len(inputs.RemoveCallbackURLs) > 0 {
+		callbackURLs := splitCallbackURLs(stringList(oidc["callbackURLs"]))
+		callbackURLs = removeValues(appendMissing(callbackURLs, inputs.AddCallbackURLs), inputs.RemoveCallbackURLs)
+		oidc["callbackURLs"] = joinCallbackURLs(callbackURLs)
+	}
+	if len(inputs.AddAllowedOrigins) > 0 || len(inputs.RemoveAllowedOrigins) > 0 {
+		allowedOrigins := stringList(oidc["allowedOrigins"])
+		oidc["allowedOrigins"] = nonNil(removeValues(appendMissing(allowedOrigins, inputs.AddAllowedOrigins), inputs.RemoveAllowedOrigins))
+	}
+	if inputs.PKCEMandatory != nil {
+		childMap(oidc, "pkce")["mandatory"] = *inputs.PKCEMandatory
+	}
+	if inputs.AccessTokenExpiry != nil {
+		accessToken := childMap(oidc, "accessToken")
+		accessToken["userAccessTokenExpiryInSeconds"] = *inputs.AccessTokenExpiry
+		accessToken["applicationAccessTokenExpiryInSeconds"] = *inputs.AccessTokenExpiry
+	}
+	if inputs.RefreshTokenExpiry != nil {
+		childMap(oidc, "refreshToken")["expiryInSeconds"] = *inputs.RefreshTokenExpiry
+	}
+}
+
+// splitCallbackURLs expands the regexp=(a|b) form the server uses to store multiple callback URLs.
+func splitCallbackURLs(callbackURLs []string) []string {
+	var urls []string
+	for _, callbackURL := range callbackURLs {
+		if strings.HasPrefix(callbackURL, "regexp=(") && strings.HasSuffix(callbackURL, ")") {
+			urls = append(urls, strings.Split(strings.TrimSuffix(strings.TrimPrefix(callbackURL, "regexp=("), ")"), "|")...)
+			continue
+		}
+		urls = append(urls, callbackURL)
+	}
+	return urls
+}
+
+// joinCallbackURLs collapses multiple callback URLs into the regexp=(a|b) form the server expects.
+func joinCallbackURLs(urls []string) []string {
+	if len(urls) <= 1 {
+		return nonNil(urls)
+	}
+	return []string{"regexp=(" + strings.Join(urls, "|") + ")"}
+}
+
+func stringList(value interface{}) []string {
+	values, _ := value.([]interface{})
+	var list []string
+	for _, v := range values {
+		if s, ok := v.(string); ok {
+			list = append(list, s)
+		}
+	}
+	return list
+}
+
+func childMap(parent map[string]interface{}, key string) map[string]interface{} {
+	child, ok := parent[key].(map[string]interface{})
+	if !ok {
+		child = map[string]interface{}{}
+		parent[key] = child
+	}
+	return child
+}
+
+func appendMissing(values, additions []string) []string {
+	for _, addition := range additions {
+		if !slices.Contains(values, addition) {
+			values = append(values, addition)
+		}
+	}
+	return values
+}
+
+func removeValues(values, removals []string) []string {
+	var result []string
+	for _, value := range values {
+		if !slices.Contains(removals, value) {
+			result = append(result, value)
+		}
+	}
+	return result
+}
+
+// applicationProtocol returns the inbound protocol of the application.
+func applicationProtocol(application *models.Application) string {
+	for _, protocol := range application.InboundProtocols {
+		switch protocol.Type {
+		case "oauth2", "oidc":
+			return ProtocolOIDC
+		case "samlsso", "saml":
+			return ProtocolSAML
+		}
+	}
+	if application.ClientID != "" {
+		return ProtocolOIDC
+	}
+	if application.Issuer != "" {
+		return ProtocolSAML
+	}
+	return ""
+}
+
+// ApplicationTemplateNames returns the names of the supported application templates.
+func ApplicationTemplateNames() []string {
+	var names []string
+	for _, template := range ApplicationTemplates {
+		names = append(names, string(template))
+	}
+	return names
+}
+
+func nonNil(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
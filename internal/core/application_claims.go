@@ -0,0 +1,245 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/shashimalcse/asgardeo-cli/internal/models"
+)
+
+const (
+	localClaimDialect  = "LOCAL"
+	customClaimDialect = "CUSTOM"
+	usernameClaimURI   = LocalClaimDialectURI + "/username"
+	rolesClaimURI      = LocalClaimDialectURI + "/roles"
+)
+
+// ApplicationClaims is the claim configuration of an application as it is shown on the command
+// line: the user attributes released to the application and the claim its subject is taken from.
+type ApplicationClaims struct {
+	Claims  []ApplicationClaim `json:"claims"`
+	Subject ApplicationSubject `json:"subject"`
+}
+
+// ApplicationClaim is a user attribute released to an application, with the OIDC claims it is
+// released as and the OIDC scopes releasing them in ID tokens and userinfo responses.
+type ApplicationClaim struct {
+	URI        string   `json:"uri"`
+	OIDCClaims []string `json:"oidcClaims,omitempty"`
+	Scopes     []string `json:"scopes,omitempty"`
+	Mandatory  bool     `json:"mandatory"`
+}
+
+type ApplicationSubject struct {
+	Claim               string `json:"claim"`
+	IncludeUserDomain   bool   `json:"includeUserDomain"`
+	IncludeTenantDomain bool   `json:"includeTenantDomain"`
+}
+
+// ApplicationClaimsInputs holds the changes to apply to the claim configuration of an application.
+// Nil fields are left unchanged.
+type ApplicationClaimsInputs struct {
+	// Requested replaces the requested claims. Claims are given as local claim URIs or names, or as
+	// OIDC claims, such as email, which are replaced by the local claims they are mapped to.
+	Requested *[]string
+	// Mandatory replaces the requested claims the users must provide.
+	Mandatory           *[]string
+	SubjectClaim        *string
+	IncludeUserDomain   *bool
+	IncludeTenantDomain *bool
+}
+
+// GetApplicationClaims returns the application with the claims it receives.
+func GetApplicationClaims(ctx context.Context, cli *CLI, idOrName string) (*models.Application, *ApplicationClaims, error) {
+	application, err := ResolveApplication(ctx, cli, idOrName)
+	if err != nil {
+		return nil, nil, err
+	}
+	resolver, err := newClaimResolver(ctx, cli)
+	if err != nil {
+		return nil, nil, err
+	}
+	claims, err := resolver.applicationClaims(ctx, cli, application.ClaimConfiguration)
+	if err != nil {
+		return nil, nil, err
+	}
+	return application, claims, nil
+}
+
+// SetApplicationClaims updates the requested claims and the subject settings of the application.
+// The claims are checked against the local claims and the OIDC dialect before the application is
+// patched.
+func SetApplicationClaims(ctx context.Context, cli *CLI, idOrName string, inputs ApplicationClaimsInputs) (*models.Application, *ApplicationClaims, error) {
+	if inputs == (ApplicationClaimsInputs{}) {
+		return nil, nil, fmt.Errorf("no changes were given")
+	}
+	application, err := ResolveApplication(ctx, cli, idOrName)
+	if err != nil {
+		return nil, nil, err
+	}
+	configuration := &models.ClaimConfiguration{Dialect: localClaimDialect}
+	if application.ClaimConfiguration != nil {
+		current := *application.ClaimConfiguration
+		configuration = &current
+	}
+	if strings.EqualFold(configuration.Dialect, customClaimDialect) {
+		return nil, nil, fmt.Errorf("application %s maps its own claims to local claims, which is not supported, switch it to the local dialect in the console first", application.Name)
+	}
+	configuration.Dialect = localClaimDialect
+	if configuration.ClaimMappings == nil {
+		configuration.ClaimMappings = []models.ClaimMapping{}
+	}
+	resolver, err := newClaimResolver(ctx, cli)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if inputs.Requested != nil {
+		mandatory := map[string]bool{}
+		for _, claim := range configuration.RequestedClaims {
+			mandatory[claim.Claim.URI] = claim.Mandatory
+		}
+		requested := []models.RequestedClaim{}
+		for _, name := range *inputs.Requested {
+			uri, err := resolver.resolve(name)
+			if err != nil {
+				return nil, nil, err
+			}
+			if slices.ContainsFunc(requested, func(claim models.RequestedClaim) bool { return claim.Claim.URI == uri }) {
+				return nil, nil, fmt.Errorf("claim %s is requested more than once", uri)
+			}
+			requested = append(requested, models.RequestedClaim{Claim: models.Claim{URI: uri}, Mandatory: mandatory[uri]})
+		}
+		configuration.RequestedClaims = requested
+	}
+	if inputs.Mandatory != nil {
+		var mandatory []string
+		for _, name := range *inputs.Mandatory {
+			uri, err := resolver.resolve(name)
+			if err != nil {
+				return nil, nil, err
+			}
+			if !slices.ContainsFunc(configuration.RequestedClaims, func(claim models.RequestedClaim) bool { return claim.Claim.URI == uri }) {
+				return nil, nil, fmt.Errorf("claim %s is mandatory but not requested", uri)
+			}
+			mandatory = append(mandatory, uri)
+		}
+		for i := range configuration.RequestedClaims {
+			configuration.RequestedClaims[i].Mandatory = slices.Contains(mandatory, configuration.RequestedClaims[i].Claim.URI)
+		}
+	}
+	if inputs.SubjectClaim != nil {
+		uri, err := resolver.resolve(*inputs.SubjectClaim)
+		if err != nil {
+			return nil, nil, err
+		}
+		configuration.Subject.Claim.URI = uri
+	}
+	if subject := configuration.Subject.Claim.URI; subject != "" && subject != usernameClaimURI &&
+		!slices.ContainsFunc(configuration.RequestedClaims, func(claim models.RequestedClaim) bool { return claim.Claim.URI == subject }) {
+		return nil, nil, fmt.Errorf("the subject claim %s must be one of the requested claims", subject)
+	}
+	if inputs.IncludeUserDomain != nil {
+		configuration.Subject.IncludeUserDomain = *inputs.IncludeUserDomain
+	}
+	if inputs.IncludeTenantDomain != nil {
+		configuration.Subject.IncludeTenantDomain = *inputs.IncludeTenantDomain
+	}
+	if configuration.RequestedClaims == nil {
+		configuration.RequestedClaims = []models.RequestedClaim{}
+	}
+	// Applications created without a claim configuration get the defaults of the console.
+	if configuration.Subject.Claim.URI == "" {
+		configuration.Subject.Claim.URI = usernameClaimURI
+	}
+	if configuration.Role.Claim.URI == "" {
+		configuration.Role.Claim.URI = rolesClaimURI
+	}
+
+	patch := map[string]interface{}{"claimConfiguration": configuration}
+	if err := cli.API.Application.Patch(ctx, application.ID, patch); err != nil {
+		return nil, nil, fmt.Errorf("failed to update the claims of application %s: %w", application.Name, err)
+	}
+	application.ClaimConfiguration = configuration
+	claims, err := resolver.applicationClaims(ctx, cli, configuration)
+	if err != nil {
+		return nil, nil, err
+	}
+	return application, claims, nil
+}
+
+// claimResolver resolves claims given on the command line against the local claims and the
+// claims of the OIDC dialect.
+type claimResolver struct {
+	local []models.LocalClaim
+	oidc  []models.ExternalClaim
+}
+
+func newClaimResolver(ctx context.Context, cli *CLI) (*claimResolver, error) {
+	local, err := cli.API.Claim.ListLocalClaims(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list local claims: %w", err)
+	}
+	_, oidc, err := ListExternalClaims(ctx, cli, OIDCClaimDialectURI)
+	if err != nil {
+		return nil, err
+	}
+	return &claimResolver{local: local, oidc: oidc}, nil
+}
+
+// resolve returns the URI of the local claim given by its URI, by its name, or by the OIDC claim
+// mapped to it. OIDC claims take precedence over local claims of the same name.
+func (r *claimResolver) resolve(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if !strings.Contains(name, "/") {
+		for _, claim := range r.oidc {
+			if claim.ClaimURI == name {
+				return claim.MappedLocalClaimURI, nil
+			}
+		}
+	}
+	uri := LocalClaimURI(name)
+	if slices.ContainsFunc(r.local, func(claim models.LocalClaim) bool { return claim.ClaimURI == uri }) {
+		return uri, nil
+	}
+	return "", fmt.Errorf("unknown claim %q, give a local claim or a claim of the OIDC dialect", name)
+}
+
+// oidcClaims returns the OIDC claims mapped to the local claim.
+func (r *claimResolver) oidcClaims(uri string) []string {
+	var claims []string
+	for _, claim := range r.oidc {
+		if claim.MappedLocalClaimURI == uri {
+			claims = append(claims, claim.ClaimURI)
+		}
+	}
+	return claims
+}
+
+func (r *claimResolver) applicationClaims(ctx context.Context, cli *CLI, configuration *models.ClaimConfiguration) (*ApplicationClaims, error) {
+	claims := &ApplicationClaims{Claims: []ApplicationClaim{}, Subject: ApplicationSubject{Claim: usernameClaimURI}}
+	if configuration == nil {
+		return claims, nil
+	}
+	scopes, err := cli.API.OIDCScope.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list OIDC scopes: %w", err)
+	}
+	for _, requested := range configuration.RequestedClaims {
+		claim := ApplicationClaim{URI: requested.Claim.URI, Mandatory: requested.Mandatory, OIDCClaims: r.oidcClaims(requested.Claim.URI)}
+		for _, scope := range scopes {
+			if slices.ContainsFunc(claim.OIDCClaims, func(oidcClaim string) bool { return slices.Contains(scope.Claims, oidcClaim) }) {
+				claim.Scopes = append(claim.Scopes, scope.Name)
+			}
+		}
+		claims.Claims = append(claims.Claims, claim)
+	}
+	if configuration.Subject.Claim.URI != "" {
+		claims.Subject.Claim = configuration.Subject.Claim.URI
+	}
+	claims.Subject.IncludeUserDomain = configuration.Subject.IncludeUserDomain
+	claims.Subject.IncludeTenantDomain = configuration.Subject.IncludeTenantDomain
+	return claims, nil
+}
@@ -0,0 +1,199 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/shashimalcse/asgardeo-cli/internal/models"
+)
+
+// ApplicationShares is an application with the organizations it is shared with, which are the
+// organizations whose users can currently sign in to it.
+type ApplicationShares struct {
+	ApplicationID string                `json:"applicationId"`
+	Application   string                `json:"application"`
+	Organizations []models.Organization `json:"organizations"`
+}
+
+// ApplicationShareInputs selects the organizations an application is shared with or unshared from.
+type ApplicationShareInputs struct {
+	// AllOrganizations selects every child organization. When sharing, organizations created later
+	// get the application as well.
+	AllOrganizations bool
+	// Organizations are the IDs or names of the organizations.
+	Organizations []string
+}
+
+func (i ApplicationShareInputs) validate() error {
+	if i.AllOrganizations == (len(i.Organizations) > 0) {
+		return fmt.Errorf("give either the organizations or all organizations")
+	}
+	return nil
+}
+
+// ShareApplication shares the application with the organizations and returns the organizations it
+// is shared with afterwards.
+func ShareApplication(ctx context.Context, cli *CLI, idOrName string, inputs ApplicationShareInputs) (*ApplicationShares, error) {
+	if err := inputs.validate(); err != nil {
+		return nil, err
+	}
+	application, err := ResolveApplication(ctx, cli, idOrName)
+	if err != nil {
+		return nil, err
+	}
+	organizations, err := cli.API.Organization.List(ctx, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list organizations: %w", err)
+	}
+	share := &models.ApplicationShare{ShareWithAllChildren: inputs.AllOrganizations}
+	for _, idOrName := range inputs.Organizations {
+		organization, err := matchOrganization(organizations, idOrName)
+		if err != nil {
+			return nil, err
+		}
+		if !slices.Contains(share.SharedOrganizations, organization.ID) {
+			share.SharedOrganizations = append(share.SharedOrganizations, organization.ID)
+		}
+	}
+	owner, err := ownerOrganizationID(ctx, cli, organizations)
+	if err != nil {
+		return nil, err
+	}
+	if err := cli.API.Organization.ShareApplication(ctx, owner, application.ID, share); err != nil {
+		return nil, fmt.Errorf("failed to share application %s: %w", application.Name, err)
+	}
+	return applicationShares(ctx, cli, owner, application)
+}
+
+// UnshareApplication stops sharing the application with the organizations and returns the
+// organizations it is still shared with.
+func UnshareApplication(ctx context.Context, cli *CLI, idOrName string, inputs ApplicationShareInputs) (*ApplicationShares, error) {
+	if err := inputs.validate(); err != nil {
+		return nil, err
+	}
+	application, err := ResolveApplication(ctx, cli, idOrName)
+	if err != nil {
+		return nil, err
+	}
+	organizations, err := cli.API.Organization.List(ctx, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list organizations: %w", err)
+	}
+	owner, err := ownerOrganizationID(ctx, cli, organizations)
+	if err != nil {
+		return nil, err
+	}
+	if inputs.AllOrganizations {
+		if err := cli.API.Organization.UnshareApplicationWithAll(ctx, owner, application.ID); err != nil {
+			return nil, fmt.Errorf("failed to unshare application %s: %w", application.Name, err)
+		}
+		return applicationShares(ctx, cli, owner, application)
+	}
+	shares, err := applicationShares(ctx, cli, owner, application)
+	if err != nil {
+		return nil, err
+	}
+	var unshared []models.Organization
+	for _, idOrName := range inputs.Organizations {
+		organization, err := matchOrganization(organizations, idOrName)
+		if err != nil {
+			return nil, err
+		}
+		if !slices.ContainsFunc(shares.Organizations, func(shared models.Organization) bool { return shared.ID == organization.ID }) {
+			return nil, fmt.Errorf("application %s is not shared with organization %s", application.Name, organization.Name)
+		}
+		unshared = append(unshared, *organization)
+	}
+	for _, organization := range unshared {
+		if err := cli.API.Organization.UnshareApplication(ctx, owner, application.ID, organization.ID); err != nil {
+			return nil, fmt.Errorf("failed to unshare application %s from organization %s: %w", application.Name, organization.Name, err)
+		}
+	}
+	return applicationShares(ctx, cli, owner, application)
+}
+
+// ListApplicationShares returns the organizations the application is shared with, or the
+// organizations every application is shared with when no application is given.
+func ListApplicationShares(ctx context.Context, cli *CLI, idOrName string) ([]ApplicationShares, error) {
+	var applications []models.Application
+	if idOrName != "" {
+		application, err := ResolveApplication(ctx, cli, idOrName)
+		if err != nil {
+			return nil, err
+		}
+		applications = append(applications, *application)
+	} else {
+		list, err := cli.API.Application.List(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list applications: %w", err)
+		}
+		applications = list.Applications
+	}
+	organizations, err := cli.API.Organization.List(ctx, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list organizations: %w", err)
+	}
+	owner, err := ownerOrganizationID(ctx, cli, organizations)
+	if err != nil {
+		return nil, err
+	}
+	shares := []ApplicationShares{}
+	for _, application := range applications {
+		share, err := applicationShares(ctx, cli, owner, &application)
+		if err != nil {
+			return nil, err
+		}
+		shares = append(shares, *share)
+	}
+	return shares, nil
+}
+
+func applicationShares(ctx context.Context, cli *CLI, owner string, application *models.Application) (*ApplicationShares, error) {
+	organizations, err := cli.API.Organization.ListSharedOrganizations(ctx, owner, application.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the organizations application %s is shared with: %w", application.Name, err)
+	}
+	if organizations == nil {
+		organizations = []models.Organization{}
+	}
+	return &ApplicationShares{ApplicationID: application.ID, Application: application.Name, Organizations: organizations}, nil
+}
+
+// ownerOrganizationID returns the ID of the organization the applications belong to, which the
+// sharing endpoints are addressed by. That is the organization the tenant is switched to or, for
+// the root organization, the parent of its top level organizations, as the ID of the root
+// organization is not known otherwise.
+func ownerOrganizationID(ctx context.Context, cli *CLI, organizations []models.Organization) (string, error) {
+	if !cli.RootOrganization {
+		current, err := CurrentOrganization(cli)
+		if err != nil {
+			return "", err
+		}
+		if current != nil {
+			return current.ID, nil
+		}
+	}
+	if len(organizations) == 0 {
+		return "", fmt.Errorf("there are no organizations to share applications with, create one using `asgardeo orgs create`")
+	}
+	listed := map[string]bool{}
+	for _, organization := range organizations {
+		listed[organization.ID] = true
+	}
+	id := organizations[0].ID
+	for range organizations {
+		organization, err := cli.API.Organization.Get(ctx, id)
+		if err != nil {
+			return "", fmt.Errorf("failed to get organization: %w", err)
+		}
+		if organization.Parent == nil || organization.Parent.ID == "" {
+			break
+		}
+		if !listed[organization.Parent.ID] {
+			return organization.Parent.ID, nil
+		}
+		id = organization.Parent.ID
+	}
+	return "", fmt.Errorf("failed to find the root organization of the tenant")
+}
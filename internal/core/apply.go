@@ -0,0 +1,619 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/shashimalcse/asgardeo-cli/internal/models"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	KindApplication = "application"
+	KindAPIResource = "api-resource"
+
+	OperationCreate = "create"
+	OperationUpdate = "update"
+	OperationDelete = "delete"
+)
+
+// protectedApplications are system applications that are never pruned.
+var protectedApplications = []string{"Console", "My Account"}
+
+// Manifest describes the desired state of the resources of a tenant. Resources are matched
+// by name or identifier, so the same manifests can be applied to any tenant.
+type Manifest struct {
+	Applications []ApplicationManifest `json:"applications,omitempty"`
+	APIResources []APIResourceManifest `json:"apiResources,omitempty"`
+	// Warnings are raised while loading the manifests, for fields that are read but not applied.
+	Warnings []string `json:"-"`
+}
+
+// ApplicationManifest describes an application. The template inputs are used to create the
+// application, while the remaining fields are kept in sync on every apply.
+type ApplicationManifest struct {
+	ApplicationCreateInputs
+	Description       string `json:"description,omitempty"`
+	SkipLoginConsent  *bool  `json:"skipLoginConsent,omitempty"`
+	SkipLogoutConsent *bool  `json:"skipLogoutConsent,omitempty"`
+	// The configurations below replace the configurations of the application when given. The
+	// consent settings above take precedence over the ones in the advanced configurations.
+	AuthenticationSequence *models.AuthenticationSequence `json:"authenticationSequence,omitempty"`
+	ClaimConfiguration     *models.ClaimConfiguration     `json:"claimConfiguration,omitempty"`
+	AdvancedConfigurations *models.AdvancedConfigurations `json:"advancedConfigurations,omitempty"`
+}
+
+// APIResourceManifest describes an api resource and its scopes.
+type APIResourceManifest struct {
+	Identifier            string         `json:"identifier"`
+	Name                  string         `json:"name"`
+	Description           string         `json:"description,omitempty"`
+	RequiresAuthorization *bool          `json:"requiresAuthorization,omitempty"`
+	Scopes                []models.Scope `json:"scopes,omitempty"`
+}
+
+// LoadManifests reads the manifests in the given files and directories. Directories are walked
+// recursively for .yaml, .yml and .json files, and YAML files may hold multiple documents.
+func LoadManifests(paths []string) (*Manifest, error) {
+	manifest := &Manifest{}
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read manifests: %w", err)
+		}
+		if !info.IsDir() {
+			if err := manifest.loadFile(path); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		err = filepath.WalkDir(path, func(file string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() || !isManifestFile(file) {
+				return nil
+			}
+			return manifest.loadFile(file)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := manifest.Validate(); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+func isManifestFile(path string) bool {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml", ".json":
+		return true
+	}
+	return false
+}
+
+func (m *Manifest) loadFile(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to read manifest: %w", err)
+	}
+	defer file.Close()
+	// JSON is a subset of YAML, so both are read with the YAML decoder and then mapped
+	// through JSON to honour the json tags of the manifest types.
+	decoder := yaml.NewDecoder(file)
+	for {
+		var document interface{}
+		if err := decoder.Decode(&document); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return fmt.Errorf("failed to parse manifest %s: %w", path, err)
+		}
+		if document == nil {
+			continue
+		}
+		buffer, err := json.Marshal(document)
+		if err != nil {
+			return fmt.Errorf("failed to parse manifest %s: %w", path, err)
+		}
+		// Applications are read as exported, so that the fields exported for review only are
+		// reported, while unknown fields are rejected rather than silently ignored.
+		var part struct {
+			Applications []ApplicationExport   `json:"applications"`
+			APIResources []APIResourceManifest `json:"apiResources"`
+		}
+		jsonDecoder := json.NewDecoder(bytes.NewReader(buffer))
+		jsonDecoder.DisallowUnknownFields()
+		if err := jsonDecoder.Decode(&part); err != nil {
+			return fmt.Errorf("failed to parse manifest %s: %w", path, err)
+		}
+		for _, application := range part.Applications {
+			var ignored []string
+			if application.InboundProtocolConfiguration != nil {
+				ignored = append(ignored, "inboundProtocolConfiguration")
+			}
+			if application.AssociatedRoles != nil {
+				ignored = append(ignored, "associatedRoles")
+			}
+			if len(ignored) > 0 {
+				m.Warnings = append(m.Warnings, fmt.Sprintf("%s of application %q are exported for review only and are not applied", strings.Join(ignored, " and "), application.Name))
+			}
+			m.Applications = append(m.Applications, application.ApplicationManifest)
+		}
+		m.APIResources = append(m.APIResources, part.APIResources...)
+	}
+}
+
+// Validate checks the manifest for missing fields and duplicate resources.
+func (m *Manifest) Validate() error {
+	applications := map[string]bool{}
+	for _, application := range m.Applications {
+		if err := application.Validate(); err != nil {
+			return fmt.Errorf("invalid application %q: %w", application.Name, err)
+		}
+		if applications[application.Name] {
+			return fmt.Errorf("application %q is defined more than once", application.Name)
+		}
+		applications[application.Name] = true
+	}
+	apiResources := map[string]bool{}
+	for _, apiResource := range m.APIResources {
+		if apiResource.Identifier == "" || apiResource.Name == "" {
+			return fmt.Errorf("api resource %q requires an identifier and a name", apiResource.Identifier)
+		}
+		if apiResources[apiResource.Identifier] {
+			return fmt.Errorf("api resource %q is defined more than once", apiResource.Identifier)
+		}
+		apiResources[apiResource.Identifier] = true
+	}
+	return nil
+}
+
+// ApplyAction is a single change required to converge the tenant to the manifests.
+type ApplyAction struct {
+	Operation string
+	Kind      string
+	Name      string
+	Changes   []string
+	apply     func(ctx context.Context) error
+}
+
+// Apply performs the change.
+func (a *ApplyAction) Apply(ctx context.Context) error {
+	return a.apply(ctx)
+}
+
+// ApplyPlan holds the actions required to converge the tenant, in the order they are applied.
+type ApplyPlan struct {
+	Actions   []*ApplyAction
+	Unchanged int
+	Warnings  []string
+}
+
+// PlanApply compares the manifest with the resources of the tenant. With prune, resources that
+// are missing from the manifest are deleted.
+func PlanApply(ctx context.Context, cli *CLI, manifest *Manifest, prune bool) (*ApplyPlan, error) {
+	plan := &ApplyPlan{Warnings: slices.Clone(manifest.Warnings)}
+	var deletions []*ApplyAction
+
+	apiResources, err := cli.API.APIResource.List(ctx, "BUSINESS")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list api resources: %w", err)
+	}
+	existingAPIResources := map[string]models.APIResource{}
+	for _, apiResource := range apiResources.APIResources {
+		existingAPIResources[apiResource.Identifier] = apiResource
+	}
+	for _, desired := range manifest.APIResources {
+		current, ok := existingAPIResources[desired.Identifier]
+		if !ok {
+			plan.Actions = append(plan.Actions, createAPIResourceAction(cli, desired))
+			continue
+		}
+		action, err := updateAPIResourceAction(ctx, cli, desired, current)
+		if err != nil {
+			return nil, err
+		}
+		if action == nil {
+			plan.Unchanged++
+			continue
+		}
+		plan.Actions = append(plan.Actions, action)
+	}
+	if prune {
+		for _, apiResource := range apiResources.APIResources {
+			if !slices.ContainsFunc(manifest.APIResources, func(desired APIResourceManifest) bool { return desired.Identifier == apiResource.Identifier }) {
+				deletions = append(deletions, deleteAPIResourceAction(cli, apiResource))
+			}
+		}
+	}
+
+	applications, err := cli.API.Application.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list applications: %w", err)
+	}
+	existingApplications := map[string]models.Application{}
+	for _, application := range applications.Applications {
+		existingApplications[application.Name] = application
+	}
+	for _, desired := range manifest.Applications {
+		current, ok := existingApplications[desired.Name]
+		if !ok {
+			plan.Actions = append(plan.Actions, createApplicationAction(cli, desired))
+			continue
+		}
+		action, err := updateApplicationAction(ctx, cli, plan, desired, current)
+		if err != nil {
+			return nil, err
+		}
+		if action == nil {
+			plan.Unchanged++
+			continue
+		}
+		plan.Actions = append(plan.Actions, action)
+	}
+	if prune {
+		ownClientID := ""
+		if tenant, err := cli.Config.GetTenant(cli.Tenant); err == nil {
+			ownClientID = tenant.ClientID
+		}
+		for _, application := range applications.Applications {
+			if slices.ContainsFunc(manifest.Applications, func(desired ApplicationManifest) bool { return desired.Name == application.Name }) {
+				continue
+			}
+			if slices.Contains(protectedApplications, application.Name) || (ownClientID != "" && application.ClientID == ownClientID) {
+				plan.Warnings = append(plan.Warnings, fmt.Sprintf("application %q is not pruned as it is required by the tenant or the CLI", application.Name))
+				continue
+			}
+			deletions = append(deletions, deleteApplicationAction(cli, application))
+		}
+	}
+
+	// Applications are deleted before the api resources they may be authorized to.
+	sort.SliceStable(deletions, func(i, j int) bool {
+		return deletions[i].Kind == KindApplication && deletions[j].Kind != KindApplication
+	})
+	plan.Actions = append(plan.Actions, deletions...)
+	return plan, nil
+}
+
+func createAPIResourceAction(cli *CLI, desired APIResourceManifest) *ApplyAction {
+	requiresAuthorization := true
+	if desired.RequiresAuthorization != nil {
+		requiresAuthorization = *desired.RequiresAuthorization
+	}
+	var changes []string
+	for _, scope := range desired.Scopes {
+		changes = append(changes, "add scope "+scope.Name)
+	}
+	return &ApplyAction{
+		Operation: OperationCreate,
+		Kind:      KindAPIResource,
+		Name:      desired.Identifier,
+		Changes:   changes,
+		apply: func(ctx context.Context) error {
+			payload := map[string]interface{}{
+				"identifier":            desired.Identifier,
+				"name":                  desired.Name,
+				"requiresAuthorization": requiresAuthorization,
+				"scopes":                scopesOrEmpty(desired.Scopes),
+			}
+			if desired.Description != "" {
+				payload["description"] = desired.Description
+			}
+			return cli.API.APIResource.Create(ctx, payload)
+		},
+	}
+}
+
+func updateAPIResourceAction(ctx context.Context, cli *CLI, desired APIResourceManifest, current models.APIResource) (*ApplyAction, error) {
+	apiResource, err := cli.API.APIResource.Get(ctx, current.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get api resource %s: %w", desired.Identifier, err)
+	}
+	var changes []string
+	patch := map[string]interface{}{}
+	if desired.RequiresAuthorization != nil && *desired.RequiresAuthorization != apiResource.RequiresAuthorization {
+		patch["requiresAuthorization"] = *desired.RequiresAuthorization
+		changes = append(changes, fmt.Sprintf("set requiresAuthorization to %t", *desired.RequiresAuthorization))
+	}
+	if desired.Name != apiResource.Name {
+		patch["name"] = desired.Name
+		changes = append(changes, fmt.Sprintf("rename %q to %q", apiResource.Name, desired.Name))
+	}
+	if desired.Description != "" && desired.Description != apiResource.Description {
+		patch["description"] = desired.Description
+		changes = append(changes, "update description")
+	}
+	scopeChanges := diffScopes(apiResource.Scopes, desired.Scopes)
+	changes = append(changes, scopeChanges...)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	return &ApplyAction{
+		Operation: OperationUpdate,
+		Kind:      KindAPIResource,
+		Name:      desired.Identifier,
+		Changes:   changes,
+		apply: func(ctx context.Context) error {
+			if len(patch) > 0 {
+				if err := cli.API.APIResource.Patch(ctx, apiResource.ID, patch); err != nil {
+					return err
+				}
+			}
+			if len(scopeChanges) > 0 {
+				return cli.API.APIResource.PutScopes(ctx, apiResource.ID, scopesOrEmpty(desired.Scopes))
+			}
+			return nil
+		},
+	}, nil
+}
+
+func deleteAPIResourceAction(cli *CLI, apiResource models.APIResource) *ApplyAction {
+	return &ApplyAction{
+		Operation: OperationDelete,
+		Kind:      KindAPIResource,
+		Name:      apiResource.Identifier,
+		apply: func(ctx context.Context) error {
+			return cli.API.APIResource.Delete(ctx, apiResource.ID)
+		},
+	}
+}
+
+// diffScopes describes the changes needed to turn the current scopes into the desired scopes.
+func diffScopes(current, desired []models.Scope) []string {
+	var changes []string
+	currentScopes := map[string]models.Scope{}
+	for _, scope := range current {
+		currentScopes[scope.Name] = scope
+	}
+	desiredScopes := map[string]bool{}
+	for _, scope := range desired {
+		desiredScopes[scope.Name] = true
+		existing, ok := currentScopes[scope.Name]
+		switch {
+		case !ok:
+			changes = append(changes, "add scope "+scope.Name)
+		case existing.DisplayName != scope.DisplayName || existing.Description != scope.Description:
+			changes = append(changes, "update scope "+scope.Name)
+		}
+	}
+	for _, scope := range current {
+		if !desiredScopes[scope.Name] {
+			changes = append(changes, "remove scope "+scope.Name)
+		}
+	}
+	return changes
+}
+
+func scopesOrEmpty(scopes []models.Scope) []models.Scope {
+	if scopes == nil {
+		return []models.Scope{}
+	}
+	for i := range scopes {
+		if scopes[i].DisplayName == "" {
+			scopes[i].DisplayName = scopes[i].Name
+		}
+	}
+	return scopes
+}
+
+func createApplicationAction(cli *CLI, desired ApplicationManifest) *ApplyAction {
+	return &ApplyAction{
+		Operation: OperationCreate,
+		Kind:      KindApplication,
+		Name:      desired.Name,
+		Changes:   []string{"template " + string(desired.Template)},
+		apply: func(ctx context.Context) error {
+			application, err := CreateApplication(ctx, cli, desired.ApplicationCreateInputs)
+			if err != nil {
+				return err
+			}
+			inputs := ApplicationUpdateInputs{SkipLoginConsent: desired.SkipLoginConsent, SkipLogoutConsent: desired.SkipLogoutConsent}
+			if desired.Description != "" {
+				inputs.Description = &desired.Description
+			}
+			if inputs.hasApplicationChanges() {
+				if _, err := updateApplication(ctx, cli, application, inputs); err != nil {
+					return err
+				}
+			}
+			if patch, _ := applicationConfigurationPatch(desired, nil); len(patch) > 0 {
+				return cli.API.Application.Patch(ctx, application.ID, patch)
+			}
+			return nil
+		},
+	}
+}
+
+func updateApplicationAction(ctx context.Context, cli *CLI, plan *ApplyPlan, desired ApplicationManifest, current models.Application) (*ApplyAction, error) {
+	application, err := cli.API.Application.Get(ctx, current.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get application %s: %w", desired.Name, err)
+	}
+	if err := loadInboundProtocolConfiguration(ctx, cli, application, false); err != nil {
+		return nil, err
+	}
+	var inputs ApplicationUpdateInputs
+	var changes []string
+	if desired.Description != "" && desired.Description != application.Description {
+		inputs.Description = &desired.Description
+		changes = append(changes, "update description")
+	}
+	if application.AdvancedConfig != nil && desired.AdvancedConfigurations == nil {
+		if desired.SkipLoginConsent != nil && *desired.SkipLoginConsent != application.AdvancedConfig.SkipLoginConsent {
+			inputs.SkipLoginConsent = desired.SkipLoginConsent
+			changes = append(changes, fmt.Sprintf("set skipLoginConsent to %t", *desired.SkipLoginConsent))
+		}
+		if desired.SkipLogoutConsent != nil && *desired.SkipLogoutConsent != application.AdvancedConfig.SkipLogoutConsent {
+			inputs.SkipLogoutConsent = desired.SkipLogoutConsent
+			changes = append(changes, fmt.Sprintf("set skipLogoutConsent to %t", *desired.SkipLogoutConsent))
+		}
+	}
+	if config := application.InboundProtocolConfiguration; config != nil && config.OIDC != nil {
+		if len(desired.RedirectURLs) > 0 {
+			currentURLs := splitCallbackURLs(config.OIDC.CallbackURLs)
+			inputs.AddCallbackURLs = removeValues(desired.RedirectURLs, currentURLs)
+			inputs.RemoveCallbackURLs = removeValues(currentURLs, desired.RedirectURLs)
+		}
+		if len(desired.AllowedOrigins) > 0 {
+			inputs.AddAllowedOrigins = removeValues(desired.AllowedOrigins, config.OIDC.AllowedOrigins)
+			inputs.RemoveAllowedOrigins = removeValues(config.OIDC.AllowedOrigins, desired.AllowedOrigins)
+		}
+	} else if len(desired.RedirectURLs) > 0 || len(desired.AllowedOrigins) > 0 {
+		plan.Warnings = append(plan.Warnings, fmt.Sprintf("redirect URLs and allowed origins of application %q are not synced as it is not an OIDC application", desired.Name))
+	}
+	for _, url := range inputs.AddCallbackURLs {
+		changes = append(changes, "add redirect URL "+url)
+	}
+	for _, url := range inputs.RemoveCallbackURLs {
+		changes = append(changes, "remove redirect URL "+url)
+	}
+	for _, origin := range inputs.AddAllowedOrigins {
+		changes = append(changes, "add allowed origin "+origin)
+	}
+	for _, origin := range inputs.RemoveAllowedOrigins {
+		changes = append(changes, "remove allowed origin "+origin)
+	}
+	patch, patchChanges := applicationConfigurationPatch(desired, application)
+	changes = append(changes, patchChanges...)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	return &ApplyAction{
+		Operation: OperationUpdate,
+		Kind:      KindApplication,
+		Name:      desired.Name,
+		Changes:   changes,
+		apply: func(ctx context.Context) error {
+			if inputs.hasApplicationChanges() {
+				if _, err := updateApplication(ctx, cli, application, inputs); err != nil {
+					return err
+				}
+			}
+			if len(patch) > 0 {
+				return cli.API.Application.Patch(ctx, application.ID, patch)
+			}
+			return nil
+		},
+	}, nil
+}
+
+// applicationConfigurationPatch returns the patch replacing the configurations of the application
+// that differ from the manifest, with the changes it makes. Every configuration of the manifest is
+// patched when there is no application yet.
+func applicationConfigurationPatch(desired ApplicationManifest, application *models.Application) (map[string]interface{}, []string) {
+	current := &models.Application{}
+	if application != nil {
+		current = application
+	}
+	if config := desired.AdvancedConfigurations; config != nil {
+		merged := *config
+		if desired.SkipLoginConsent != nil {
+			merged.SkipLoginConsent = *desired.SkipLoginConsent
+		}
+		if desired.SkipLogoutConsent != nil {
+			merged.SkipLogoutConsent = *desired.SkipLogoutConsent
+		}
+		desired.AdvancedConfigurations = &merged
+	}
+	patch := map[string]interface{}{}
+	var changes []string
+	if desired.AuthenticationSequence != nil && (application == nil || !sameConfiguration(desired.AuthenticationSequence, current.AuthenticationSeq)) {
+		patch["authenticationSequence"] = desired.AuthenticationSequence
+		changes = append(changes, "update login flow")
+	}
+	if desired.ClaimConfiguration != nil && (application == nil || !sameConfiguration(desired.ClaimConfiguration, current.ClaimConfiguration)) {
+		patch["claimConfiguration"] = desired.ClaimConfiguration
+		changes = append(changes, "update claim configuration")
+	}
+	if desired.AdvancedConfigurations != nil && (application == nil || !sameConfiguration(desired.AdvancedConfigurations, current.AdvancedConfig)) {
+		patch["advancedConfigurations"] = desired.AdvancedConfigurations
+		changes = append(changes, "update advanced configurations")
+	}
+	return patch, changes
+}
+
+// sameConfiguration compares two configurations through their JSON form, treating missing, null
+// and empty values alike, as the server fills in empty lists the manifests leave out.
+func sameConfiguration(desired, current interface{}) bool {
+	return reflect.DeepEqual(comparableJSON(desired), comparableJSON(current))
+}
+
+func comparableJSON(value interface{}) interface{} {
+	buffer, err := json.Marshal(value)
+	if err != nil {
+		return nil
+	}
+	var generic interface{}
+	if err := json.Unmarshal(buffer, &generic); err != nil {
+		return nil
+	}
+	return dropEmpty(generic)
+}
+
+// dropEmpty removes the null, empty and zero values of the value, recursively, returning nil when
+// nothing is left.
+func dropEmpty(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, child := range v {
+			if child = dropEmpty(child); child == nil {
+				delete(v, key)
+				continue
+			}
+			v[key] = child
+		}
+		if len(v) == 0 {
+			return nil
+		}
+	case []interface{}:
+		var children []interface{}
+		for _, child := range v {
+			if child = dropEmpty(child); child != nil {
+				children = append(children, child)
+			}
+		}
+		if len(children) == 0 {
+			return nil
+		}
+		return children
+	case string:
+		if v == "" {
+			return nil
+		}
+	case bool:
+		if !v {
+			return nil
+		}
+	case float64:
+		if v == 0 {
+			return nil
+		}
+	}
+	return value
+}
+
+func deleteApplicationAction(cli *CLI, application models.Application) *ApplyAction {
+	return &ApplyAction{
+		Operation: OperationDelete,
+		Kind:      KindApplication,
+		Name:      application.Name,
+		apply: func(ctx context.Context) error {
+			return cli.API.Application.Delete(ctx, application.ID)
+		},
+	}
+}
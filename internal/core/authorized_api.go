@@ -0,0 +1,147 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/shashimalcse/asgardeo-cli/internal/models"
+)
+
+const (
+	AuthorizationPolicyRBAC = "RBAC"
+	// AuthorizationPolicyNone lets the application request every authorized scope, whatever the
+	// roles of the user.
+	AuthorizationPolicyNone = "No Policy"
+)
+
+// authorizationPolicy returns the policy identifier of the policy given on the command line.
+func authorizationPolicy(policy string) (string, error) {
+	switch strings.ToLower(strings.ReplaceAll(policy, " ", "")) {
+	case "rbac":
+		return AuthorizationPolicyRBAC, nil
+	case "none", "nopolicy":
+		return AuthorizationPolicyNone, nil
+	}
+	return "", fmt.Errorf("invalid policy %q, use RBAC or none", policy)
+}
+
+// AuthorizeAPIInputs holds the api resource and the scopes an application is authorized to access.
+type AuthorizeAPIInputs struct {
+	API    string
+	Scopes []string
+	Policy string
+}
+
+// APIAuthorization reports the scopes granted to the application, and whether the application was
+// already authorized to access the api resource.
+type APIAuthorization struct {
+	Application *models.Application
+	APIResource *models.APIResource
+	Added       []string
+	Existing    bool
+}
+
+// AuthorizeAPI authorizes the application to access the api resource with the scopes. When the
+// application is already authorized, the missing scopes are added.
+func AuthorizeAPI(ctx context.Context, cli *CLI, idOrName string, inputs AuthorizeAPIInputs) (*APIAuthorization, error) {
+	policy, err := authorizationPolicy(inputs.Policy)
+	if err != nil {
+		return nil, err
+	}
+	application, err := ResolveApplication(ctx, cli, idOrName)
+	if err != nil {
+		return nil, err
+	}
+	apiResource, err := ResolveAPIResource(ctx, cli, inputs.API)
+	if err != nil {
+		return nil, err
+	}
+	for _, scope := range inputs.Scopes {
+		if !slices.ContainsFunc(apiResource.Scopes, func(s models.Scope) bool { return s.Name == scope }) {
+			return nil, fmt.Errorf("api resource %s has no scope %q", apiResource.Identifier, scope)
+		}
+	}
+	authorizedAPIs, err := cli.API.Application.ListAuthorizedAPIs(ctx, application.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the authorized api resources of application %s: %w", application.Name, err)
+	}
+
+	authorization := &APIAuthorization{Application: application, APIResource: apiResource}
+	index := slices.IndexFunc(authorizedAPIs, func(a models.AuthorizedAPI) bool { return a.ID == apiResource.ID })
+	if index < 0 {
+		request := &models.AuthorizedAPICreate{ID: apiResource.ID, PolicyIdentifier: policy, Scopes: nonNil(inputs.Scopes)}
+		if err := cli.API.Application.AuthorizeAPI(ctx, application.ID, request); err != nil {
+			return nil, fmt.Errorf("failed to authorize application %s to access %s: %w", application.Name, apiResource.Identifier, err)
+		}
+		authorization.Added = inputs.Scopes
+		return authorization, nil
+	}
+
+	current := authorizedAPIs[index]
+	authorization.Existing = true
+	if current.PolicyID != "" && current.PolicyID != policy {
+		return nil, fmt.Errorf("application %s is already authorized to access %s with the %s policy, revoke the access first to change the policy",
+			application.Name, apiResource.Identifier, current.PolicyID)
+	}
+	for _, scope := range inputs.Scopes {
+		if !slices.ContainsFunc(current.AuthorizedScopes, func(s models.AuthorizedScope) bool { return s.Name == scope }) &&
+			!slices.Contains(authorization.Added, scope) {
+			authorization.Added = append(authorization.Added, scope)
+		}
+	}
+	if len(authorization.Added) == 0 {
+		return authorization, nil
+	}
+	patch := &models.AuthorizedAPIPatch{AddedScopes: authorization.Added, RemovedScopes: []string{}}
+	if err := cli.API.Application.PatchAuthorizedAPI(ctx, application.ID, apiResource.ID, patch); err != nil {
+		return nil, fmt.Errorf("failed to add scopes of %s to application %s: %w", apiResource.Identifier, application.Name, err)
+	}
+	return authorization, nil
+}
+
+// ListAuthorizedAPIs returns the application with the api resources it is authorized to access.
+func ListAuthorizedAPIs(ctx context.Context, cli *CLI, idOrName string) (*models.Application, []models.AuthorizedAPI, error) {
+	application, err := ResolveApplication(ctx, cli, idOrName)
+	if err != nil {
+		return nil, nil, err
+	}
+	authorizedAPIs, err := cli.API.Application.ListAuthorizedAPIs(ctx, application.ID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list the authorized api resources of application %s: %w", application.Name, err)
+	}
+	return application, authorizedAPIs, nil
+}
+
+// RevokeAPI removes the access of the application to the api resource, given by ID or identifier.
+// When scopes are given, only these scopes are removed and the api resource stays authorized.
+func RevokeAPI(ctx context.Context, cli *CLI, idOrName, idOrIdentifier string, scopes []string) (*models.Application, *models.AuthorizedAPI, error) {
+	application, authorizedAPIs, err := ListAuthorizedAPIs(ctx, cli, idOrName)
+	if err != nil {
+		return nil, nil, err
+	}
+	index := slices.IndexFunc(authorizedAPIs, func(a models.AuthorizedAPI) bool {
+		return a.ID == idOrIdentifier || a.Identifier == idOrIdentifier
+	})
+	if index < 0 {
+		return nil, nil, fmt.Errorf("application %s is not authorized to access %s", application.Name, idOrIdentifier)
+	}
+	authorizedAPI := &authorizedAPIs[index]
+	if len(scopes) == 0 {
+		if err := cli.API.Application.RevokeAPI(ctx, application.ID, authorizedAPI.ID); err != nil {
+			return nil, nil, fmt.Errorf("failed to revoke the access of application %s to %s: %w", application.Name, authorizedAPI.Identifier, err)
+		}
+		return application, authorizedAPI, nil
+	}
+	for _, scope := range scopes {
+		if !slices.ContainsFunc(authorizedAPI.AuthorizedScopes, func(s models.AuthorizedScope) bool { return s.Name == scope }) {
+			return nil, nil, fmt.Errorf("scope %q of %s is not authorized for application %s", scope, authorizedAPI.Identifier, application.Name)
+		}
+	}
+	patch := &models.AuthorizedAPIPatch{AddedScopes: []string{}, RemovedScopes: scopes}
+	if err := cli.API.Application.PatchAuthorizedAPI(ctx, application.ID, authorizedAPI.ID, patch); err != nil {
+		return nil, nil, fmt.Errorf("failed to revoke scopes of %s from application %s: %w", authorizedAPI.Identifier, application.Name, err)
+	}
+	return application, authorizedAPI, nil
+}
@@ -0,0 +1,376 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/shashimalcse/asgardeo-cli/internal/api"
+	"github.com/shashimalcse/asgardeo-cli/internal/models"
+)
+
+// LocalClaimDialectURI is the URI of the dialect of the local claims. Local claims are given on
+// the command line by their full URI or by the name after this prefix, for example department.
+const LocalClaimDialectURI = "http://wso2.org/claims"
+
+// claimDialectAliases maps the short names accepted for claim dialects to their URIs.
+var claimDialectAliases = map[string]string{
+	"local":            LocalClaimDialectURI,
+	"oidc":             "http://wso2.org/oidc/claim",
+	"scim2":            "urn:ietf:params:scim:schemas:core:2.0",
+	"scim2-user":       "urn:ietf:params:scim:schemas:core:2.0:User",
+	"scim2-enterprise": "urn:ietf:params:scim:schemas:extension:enterprise:2.0:User",
+	"scim2-custom":     "urn:scim:schemas:extension:custom:User",
+}
+
+var attributeNamePattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*$`)
+
+// LocalClaimURI returns the URI of a local claim given by its URI or by its name.
+func LocalClaimURI(uriOrName string) string {
+	if strings.Contains(uriOrName, "/") {
+		return uriOrName
+	}
+	return LocalClaimDialectURI + "/" + uriOrName
+}
+
+// ClaimDialectAlias returns the short name of a claim dialect, or an empty string when it has none.
+func ClaimDialectAlias(dialectURI string) string {
+	for alias, uri := range claimDialectAliases {
+		if uri == dialectURI {
+			return alias
+		}
+	}
+	return ""
+}
+
+// ResolveClaimDialect finds a claim dialect by its ID, its URI or its short name, such as oidc.
+func ResolveClaimDialect(ctx context.Context, cli *CLI, idOrURI string) (*models.ClaimDialect, error) {
+	uri := idOrURI
+	if alias, ok := claimDialectAliases[strings.ToLower(idOrURI)]; ok {
+		uri = alias
+	}
+	dialects, err := cli.API.Claim.ListDialects(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list claim dialects: %w", err)
+	}
+	for i, dialect := range dialects {
+		if dialect.ID == idOrURI || dialect.DialectURI == uri {
+			return &dialects[i], nil
+		}
+	}
+	return nil, fmt.Errorf("claim dialect not found: %s", idOrURI)
+}
+
+// ResolveLocalClaim finds a local claim by its ID, its URI or its name.
+func ResolveLocalClaim(ctx context.Context, cli *CLI, idOrURI string) (*models.LocalClaim, error) {
+	claims, err := cli.API.Claim.ListLocalClaims(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list local claims: %w", err)
+	}
+	uri := LocalClaimURI(idOrURI)
+	for i, claim := range claims {
+		if claim.ID == idOrURI || claim.ClaimURI == uri {
+			return &claims[i], nil
+		}
+	}
+	return nil, fmt.Errorf("local claim not found: %s", idOrURI)
+}
+
+// LocalClaimCreateInputs holds the values of a new local claim.
+type LocalClaimCreateInputs struct {
+	// Name is the URI of the claim, or the name after http://wso2.org/claims/.
+	Name        string
+	DisplayName string
+	Description string
+	// MappedAttribute is the user store attribute the claim is stored in, the name of the claim by
+	// default.
+	MappedAttribute string
+	// Userstore is the user store the attribute is mapped in, the primary user store by default.
+	Userstore string
+	Required  bool
+	ReadOnly  bool
+	// SupportedByDefault shows the claim in the profiles of the users.
+	SupportedByDefault bool
+	RegEx              string
+	DisplayOrder       int
+}
+
+// CreateLocalClaim creates a local claim and returns the created claim.
+func CreateLocalClaim(ctx context.Context, cli *CLI, inputs LocalClaimCreateInputs) (*models.LocalClaim, error) {
+	uri := LocalClaimURI(inputs.Name)
+	name, ok := strings.CutPrefix(uri, LocalClaimDialectURI+"/")
+	if !ok || name == "" {
+		return nil, fmt.Errorf("invalid local claim %q, local claims start with %s/", inputs.Name, LocalClaimDialectURI)
+	}
+	if inputs.MappedAttribute == "" {
+		inputs.MappedAttribute = name
+	}
+	if !attributeNamePattern.MatchString(inputs.MappedAttribute) {
+		return nil, fmt.Errorf("invalid attribute %q, attributes start with a letter and contain only letters, digits and underscores", inputs.MappedAttribute)
+	}
+	if inputs.DisplayName == "" {
+		inputs.DisplayName = name
+	}
+	if inputs.Userstore == "" {
+		inputs.Userstore = cli.PrimaryUserstore()
+	}
+	if _, err := ResolveLocalClaim(ctx, cli, uri); err == nil {
+		return nil, fmt.Errorf("local claim %s already exists", uri)
+	}
+	claim := &models.LocalClaim{
+		ClaimURI:           uri,
+		DisplayName:        inputs.DisplayName,
+		Description:        inputs.Description,
+		DisplayOrder:       inputs.DisplayOrder,
+		ReadOnly:           inputs.ReadOnly,
+		Required:           inputs.Required,
+		SupportedByDefault: inputs.SupportedByDefault,
+		RegEx:              inputs.RegEx,
+		AttributeMapping:   []models.AttributeMapping{{MappedAttribute: inputs.MappedAttribute, Userstore: inputs.Userstore}},
+		Properties:         []models.ClaimProperty{},
+	}
+	id, err := cli.API.Claim.CreateLocalClaim(ctx, claim)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create local claim %s: %w", uri, err)
+	}
+	created, err := cli.API.Claim.GetLocalClaim(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get local claim: %w", err)
+	}
+	return created, nil
+}
+
+// LocalClaimUpdateInputs holds the changes to apply to a local claim. Nil fields are left unchanged.
+type LocalClaimUpdateInputs struct {
+	DisplayName     *string
+	Description     *string
+	MappedAttribute *string
+	// Userstore limits the change of the mapped attribute to the mapping of this user store, which
+	// is added when the claim is not mapped in it yet.
+	Userstore          *string
+	Required           *bool
+	ReadOnly           *bool
+	SupportedByDefault *bool
+	RegEx              *string
+	DisplayOrder       *int
+}
+
+// UpdateLocalClaim updates the local claim and returns the updated claim.
+func UpdateLocalClaim(ctx context.Context, cli *CLI, idOrURI string, inputs LocalClaimUpdateInputs) (*models.LocalClaim, error) {
+	if inputs == (LocalClaimUpdateInputs{}) {
+		return nil, fmt.Errorf("no changes were given")
+	}
+	claim, err := ResolveLocalClaim(ctx, cli, idOrURI)
+	if err != nil {
+		return nil, err
+	}
+	if inputs.DisplayName != nil {
+		if strings.TrimSpace(*inputs.DisplayName) == "" {
+			return nil, fmt.Errorf("local claim display name cannot be empty")
+		}
+		claim.DisplayName = *inputs.DisplayName
+	}
+	if inputs.Description != nil {
+		claim.Description = *inputs.Description
+	}
+	if inputs.MappedAttribute != nil {
+		if !attributeNamePattern.MatchString(*inputs.MappedAttribute) {
+			return nil, fmt.Errorf("invalid attribute %q, attributes start with a letter and contain only letters, digits and underscores", *inputs.MappedAttribute)
+		}
+		updateAttributeMappings(claim, *inputs.MappedAttribute, inputs.Userstore, cli.PrimaryUserstore())
+	} else if inputs.Userstore != nil {
+		return nil, fmt.Errorf("--userstore is only used together with --attribute")
+	}
+	if inputs.Required != nil {
+		claim.Required = *inputs.Required
+	}
+	if inputs.ReadOnly != nil {
+		claim.ReadOnly = *inputs.ReadOnly
+	}
+	if inputs.SupportedByDefault != nil {
+		claim.SupportedByDefault = *inputs.SupportedByDefault
+	}
+	if inputs.RegEx != nil {
+		claim.RegEx = *inputs.RegEx
+	}
+	if inputs.DisplayOrder != nil {
+		claim.DisplayOrder = *inputs.DisplayOrder
+	}
+	id := claim.ID
+	claim.ID = ""
+	if claim.Properties == nil {
+		claim.Properties = []models.ClaimProperty{}
+	}
+	if err := cli.API.Claim.UpdateLocalClaim(ctx, id, claim); err != nil {
+		return nil, fmt.Errorf("failed to update local claim %s: %w", claim.ClaimURI, err)
+	}
+	claim.ID = id
+	return claim, nil
+}
+
+// updateAttributeMappings maps the claim to the attribute in the given user store, or in every user
+// store the claim is mapped in when none is given. A claim without mappings is mapped in the primary
+// user store.
+func updateAttributeMappings(claim *models.LocalClaim, attribute string, userstore *string, primaryUserstore string) {
+	mapped := false
+	for i := range claim.AttributeMapping {
+		if userstore == nil || strings.EqualFold(claim.AttributeMapping[i].Userstore, *userstore) {
+			claim.AttributeMapping[i].MappedAttribute = attribute
+			mapped = true
+		}
+	}
+	if mapped {
+		return
+	}
+	store := primaryUserstore
+	if userstore != nil {
+		store = *userstore
+	}
+	claim.AttributeMapping = append(claim.AttributeMapping, models.AttributeMapping{MappedAttribute: attribute, Userstore: store})
+}
+
+// DeleteLocalClaim deletes the local claim and returns the deleted claim. Claims that external
+// claims are mapped to cannot be deleted, so the mappings are looked up first to name them.
+func DeleteLocalClaim(ctx context.Context, cli *CLI, idOrURI string) (*models.LocalClaim, error) {
+	claim, err := ResolveLocalClaim(ctx, cli, idOrURI)
+	if err != nil {
+		return nil, err
+	}
+	mappings, err := externalClaimsMappedTo(ctx, cli, claim.ClaimURI)
+	if err != nil {
+		return nil, err
+	}
+	if len(mappings) > 0 {
+		return nil, fmt.Errorf("local claim %s is mapped by %s, delete the mappings first", claim.ClaimURI, strings.Join(mappings, ", "))
+	}
+	if err := cli.API.Claim.DeleteLocalClaim(ctx, claim.ID); err != nil {
+		return nil, fmt.Errorf("failed to delete local claim %s: %w", claim.ClaimURI, err)
+	}
+	return claim, nil
+}
+
+// externalClaimsMappedTo returns the external claims mapped to the local claim, as <claim> (<dialect>).
+func externalClaimsMappedTo(ctx context.Context, cli *CLI, localClaimURI string) ([]string, error) {
+	dialects, err := cli.API.Claim.ListDialects(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list claim dialects: %w", err)
+	}
+	var mappings []string
+	for _, dialect := range dialects {
+		if dialect.ID == api.LocalDialectID || dialect.DialectURI == LocalClaimDialectURI {
+			continue
+		}
+		claims, err := cli.API.Claim.ListExternalClaims(ctx, dialect.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list the claims of dialect %s: %w", dialect.DialectURI, err)
+		}
+		for _, claim := range claims {
+			if claim.MappedLocalClaimURI == localClaimURI {
+				mappings = append(mappings, claim.ClaimURI+" ("+dialect.DialectURI+")")
+			}
+		}
+	}
+	return mappings, nil
+}
+
+// ListExternalClaims returns the dialect with its claims and the local claims they are mapped to.
+func ListExternalClaims(ctx context.Context, cli *CLI, dialectIDOrURI string) (*models.ClaimDialect, []models.ExternalClaim, error) {
+	dialect, err := resolveExternalDialect(ctx, cli, dialectIDOrURI)
+	if err != nil {
+		return nil, nil, err
+	}
+	claims, err := cli.API.Claim.ListExternalClaims(ctx, dialect.ID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list the claims of dialect %s: %w", dialect.DialectURI, err)
+	}
+	slices.SortFunc(claims, func(a, b models.ExternalClaim) int { return strings.Compare(a.ClaimURI, b.ClaimURI) })
+	return dialect, claims, nil
+}
+
+// CreateExternalClaim adds a claim to the dialect, mapped to the local claim, and returns the
+// created claim.
+func CreateExternalClaim(ctx context.Context, cli *CLI, dialectIDOrURI, claimURI, localClaim string) (*models.ExternalClaim, error) {
+	if strings.TrimSpace(claimURI) == "" {
+		return nil, fmt.Errorf("claim URI is required")
+	}
+	dialect, err := resolveExternalDialect(ctx, cli, dialectIDOrURI)
+	if err != nil {
+		return nil, err
+	}
+	local, err := ResolveLocalClaim(ctx, cli, localClaim)
+	if err != nil {
+		return nil, err
+	}
+	claims, err := cli.API.Claim.ListExternalClaims(ctx, dialect.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the claims of dialect %s: %w", dialect.DialectURI, err)
+	}
+	if slices.ContainsFunc(claims, func(claim models.ExternalClaim) bool { return claim.ClaimURI == claimURI }) {
+		return nil, fmt.Errorf("claim %s already exists in dialect %s, use 'claims mappings update' to change its mapping", claimURI, dialect.DialectURI)
+	}
+	claim := &models.ExternalClaim{ClaimURI: claimURI, MappedLocalClaimURI: local.ClaimURI}
+	id, err := cli.API.Claim.CreateExternalClaim(ctx, dialect.ID, claim)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create claim %s in dialect %s: %w", claimURI, dialect.DialectURI, err)
+	}
+	claim.ID = id
+	claim.ClaimDialectURI = dialect.DialectURI
+	return claim, nil
+}
+
+// UpdateExternalClaim maps the claim of the dialect to another local claim and returns the updated
+// claim.
+func UpdateExternalClaim(ctx context.Context, cli *CLI, dialectIDOrURI, idOrURI, localClaim string) (*models.ExternalClaim, error) {
+	dialect, claim, err := resolveExternalClaim(ctx, cli, dialectIDOrURI, idOrURI)
+	if err != nil {
+		return nil, err
+	}
+	local, err := ResolveLocalClaim(ctx, cli, localClaim)
+	if err != nil {
+		return nil, err
+	}
+	update := &models.ExternalClaim{ClaimURI: claim.ClaimURI, MappedLocalClaimURI: local.ClaimURI}
+	if err := cli.API.Claim.UpdateExternalClaim(ctx, dialect.ID, claim.ID, update); err != nil {
+		return nil, fmt.Errorf("failed to update claim %s of dialect %s: %w", claim.ClaimURI, dialect.DialectURI, err)
+	}
+	claim.MappedLocalClaimURI = local.ClaimURI
+	return claim, nil
+}
+
+// DeleteExternalClaim removes the claim from the dialect and returns the deleted claim.
+func DeleteExternalClaim(ctx context.Context, cli *CLI, dialectIDOrURI, idOrURI string) (*models.ExternalClaim, error) {
+	dialect, claim, err := resolveExternalClaim(ctx, cli, dialectIDOrURI, idOrURI)
+	if err != nil {
+		return nil, err
+	}
+	if err := cli.API.Claim.DeleteExternalClaim(ctx, dialect.ID, claim.ID); err != nil {
+		return nil, fmt.Errorf("failed to delete claim %s of dialect %s: %w", claim.ClaimURI, dialect.DialectURI, err)
+	}
+	return claim, nil
+}
+
+func resolveExternalDialect(ctx context.Context, cli *CLI, idOrURI string) (*models.ClaimDialect, error) {
+	dialect, err := ResolveClaimDialect(ctx, cli, idOrURI)
+	if err != nil {
+		return nil, err
+	}
+	if dialect.ID == api.LocalDialectID || dialect.DialectURI == LocalClaimDialectURI {
+		return nil, fmt.Errorf("the local dialect has no mappings, use 'claims local' to manage local claims")
+	}
+	return dialect, nil
+}
+
+func resolveExternalClaim(ctx context.Context, cli *CLI, dialectIDOrURI, idOrURI string) (*models.ClaimDialect, *models.ExternalClaim, error) {
+	dialect, claims, err := ListExternalClaims(ctx, cli, dialectIDOrURI)
+	if err != nil {
+		return nil, nil, err
+	}
+	for i, claim := range claims {
+		if claim.ID == idOrURI || claim.ClaimURI == idOrURI {
+			return dialect, &claims[i], nil
+		}
+	}
+	return nil, nil, fmt.Errorf("claim %s not found in dialect %s", idOrURI, dialect.DialectURI)
+}
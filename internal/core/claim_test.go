@@ -0,0 +1,49 @@
+package core
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/shashimalcse/asgardeo-cli/internal/models"
+)
+
+func TestUpdateAttributeMappings(t *testing.T) {
+	ldap := "LDAP"
+	tests := []struct {
+		name      string
+		mappings  []models.AttributeMapping
+		userstore *string
+		want      []models.AttributeMapping
+	}{
+		{
+			name:     "every mapped user store",
+			mappings: []models.AttributeMapping{{MappedAttribute: "dept", Userstore: "PRIMARY"}, {MappedAttribute: "ou", Userstore: "LDAP"}},
+			want:     []models.AttributeMapping{{MappedAttribute: "department", Userstore: "PRIMARY"}, {MappedAttribute: "department", Userstore: "LDAP"}},
+		},
+		{
+			name:      "only the given user store",
+			mappings:  []models.AttributeMapping{{MappedAttribute: "dept", Userstore: "PRIMARY"}, {MappedAttribute: "ou", Userstore: "ldap"}},
+			userstore: &ldap,
+			want:      []models.AttributeMapping{{MappedAttribute: "dept", Userstore: "PRIMARY"}, {MappedAttribute: "department", Userstore: "ldap"}},
+		},
+		{
+			name:      "user store the claim is not mapped in",
+			mappings:  []models.AttributeMapping{{MappedAttribute: "dept", Userstore: "PRIMARY"}},
+			userstore: &ldap,
+			want:      []models.AttributeMapping{{MappedAttribute: "dept", Userstore: "PRIMARY"}, {MappedAttribute: "department", Userstore: "LDAP"}},
+		},
+		{
+			name: "claim without mappings uses the primary user store",
+			want: []models.AttributeMapping{{MappedAttribute: "department", Userstore: "PRIMARY"}},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			claim := &models.LocalClaim{AttributeMapping: test.mappings}
+			updateAttributeMappings(claim, "department", test.userstore, "PRIMARY")
+			if !slices.Equal(claim.AttributeMapping, test.want) {
+				t.Errorf("updateAttributeMappings() = %+v, want %+v", claim.AttributeMapping, test.want)
+			}
+		})
+	}
+}
@@ -3,9 +3,12 @@ package core
 import (
 	"errors"
 	"fmt"
+	"net/http"
 
 	"github.com/shashimalcse/asgardeo-cli/internal/api"
+	"github.com/shashimalcse/asgardeo-cli/internal/auth"
 	"github.com/shashimalcse/asgardeo-cli/internal/config"
+	"github.com/shashimalcse/asgardeo-cli/internal/keyring"
 	"go.uber.org/zap"
 )
 
@@ -15,6 +18,10 @@ type CLI struct {
 	Logger *zap.Logger
 	Tenant string
 	API    *api.API
+	Retry  api.RetryPolicy
+	// RootOrganization makes the API address the root organization of the tenant, even when the
+	// tenant is switched to one of its organizations.
+	RootOrganization bool
 }
 
 // NewCLI creates a new CLI instance
@@ -22,6 +29,7 @@ func NewCLI(cfg *config.Config, logger *zap.Logger) *CLI {
 	return &CLI{
 		Config: cfg,
 		Logger: logger,
+		Retry:  api.DefaultRetryPolicy(),
 	}
 }
 
@@ -36,7 +44,17 @@ func (c *CLI) SetupWithAuthentication() error {
 	if err := c.checkAndRefreshAuth(); err != nil {
 		return fmt.Errorf("authentication check failed: %w", err)
 	}
-	newApi, err := api.NewAPI(c.Config, c.Tenant, c.Logger)
+	organizationID := ""
+	if !c.RootOrganization {
+		organization, err := c.checkAndSwitchOrganization()
+		if err != nil {
+			return err
+		}
+		if organization != nil {
+			organizationID = organization.ID
+		}
+	}
+	newApi, err := api.NewAPI(c.Config, c.Tenant, organizationID, c.Retry, c.Logger)
 	if err != nil {
 		return fmt.Errorf("failed to initialize API client: %w", err)
 	}
@@ -44,33 +62,86 @@ func (c *CLI) SetupWithAuthentication() error {
 	return nil
 }
 
+// PrimaryUserstore returns the primary user store of the server of the current tenant, DEFAULT on
+// Asgardeo and PRIMARY on WSO2 Identity Server.
+func (c *CLI) PrimaryUserstore() string {
+	// An unknown tenant is not bound to a server, so it falls back to the default server.
+	tenant, _ := c.Config.GetTenant(c.Tenant)
+	return tenant.PrimaryUserstore()
+}
+
 func (c *CLI) checkAndRefreshAuth() error {
 	tenant, err := c.Config.GetTenant(c.Tenant)
 	if err != nil {
 		return fmt.Errorf("failed to get tenant: %w", err)
 	}
 	err = tenant.CheckAuthenticationStatus()
-	if err != nil {
-		return fmt.Errorf("failed to check authentication status: %w", err)
-	}
 	switch {
-	case errors.Is(err, config.ErrInvalidToken):
-		c.Logger.Info("Token is invalid, attempting to refresh")
-		if err := c.refreshToken(); err != nil {
-			return fmt.Errorf("failed to refresh token: %w", err)
+	case err == nil && !tenant.HasExpiredToken():
+		return nil
+	case err == nil, errors.Is(err, config.ErrInvalidToken):
+		c.Logger.Info("Token is expired or invalid, attempting to refresh", zap.String("tenant", tenant.Name))
+		if err := c.refreshToken(tenant); err != nil {
+			c.Logger.Error("Failed to refresh token", zap.String("tenant", tenant.Name), zap.Error(err))
+			return fmt.Errorf("failed to refresh token, please authenticate again using `asgardeo login`: %w", err)
 		}
 		return nil
 	default:
-		return nil
+		return fmt.Errorf("failed to check authentication status: %w", err)
 	}
 }
 
-func (c *CLI) refreshToken() error {
-	// Implement token refresh logic here
-	// This is a placeholder and should be replaced with actual refresh logic
+// refreshToken renews the access token of the tenant, preferring the refresh token and
+// falling back to the client credentials grant with the client secret stored at login.
+func (c *CLI) refreshToken(tenant config.Tenant) error {
 	c.Logger.Info("Refreshing token")
-	// newToken := "refreshed-token"
-	// tenant.SetAccessToken(newToken)
-	// return c.Config.AddTenant(*tenant)
-	return fmt.Errorf("token refresh not implemented")
+	secret, secretErr := keyring.GetClientSecret(tenant.Name)
+	if refreshToken := tenant.GetRefreshToken(); refreshToken != "" {
+		result, err := auth.AuthenticateWithRefreshToken(http.DefaultClient, auth.RefreshTokenCredentials{
+			ClientID:     tenant.ClientID,
+			ClientSecret: secret,
+			RefreshToken: refreshToken,
+			Tenant:       tenant.Name,
+			Server:       tenant.GetServer(),
+		})
+		if err == nil {
+			return saveTenantTokens(c, tenant, result)
+		}
+		c.Logger.Warn("Failed to refresh token using the refresh token grant", zap.Error(err))
+	}
+	if secretErr != nil || secret == "" {
+		return errors.New("no refresh token or client secret is available to renew the access token")
+	}
+	result, err := auth.AuthenticateWithClientCredentials(http.DefaultClient, auth.ClientCredentials{
+		ClientID:     tenant.ClientID,
+		ClientSecret: secret,
+		Tenant:       tenant.Name,
+		Server:       tenant.GetServer(),
+	})
+	if err != nil {
+		return err
+	}
+	return saveTenantTokens(c, tenant, result)
+}
+
+// checkAndSwitchOrganization returns the organization the tenant is switched to, exchanging the
+// access token of the tenant for a new organization access token when it is missing or expired.
+func (c *CLI) checkAndSwitchOrganization() (*config.Organization, error) {
+	tenant, err := c.Config.GetTenant(c.Tenant)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant: %w", err)
+	}
+	organization := tenant.Organization
+	if organization == nil {
+		return nil, nil
+	}
+	if tenant.GetOrganizationAccessToken() != "" && !organization.HasExpiredToken() {
+		return organization, nil
+	}
+	c.Logger.Info("Organization token is expired or invalid, attempting to switch again", zap.String("tenant", tenant.Name), zap.String("organization", organization.ID))
+	if err := switchOrganization(c, tenant, *organization); err != nil {
+		c.Logger.Error("Failed to switch to the organization", zap.String("organization", organization.ID), zap.Error(err))
+		return nil, fmt.Errorf("failed to switch to organization %s, switch again using `asgardeo orgs switch` or back to the root organization using `asgardeo orgs switch --root`: %w", organization.Name, err)
+	}
+	return organization, nil
 }
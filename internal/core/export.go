@@ -0,0 +1,287 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/shashimalcse/asgardeo-cli/internal/models"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	exportApplicationsDir = "applications"
+	exportAPIResourcesDir = "api-resources"
+)
+
+var unsafeFileNameCharacters = regexp.MustCompile(`[^a-z0-9]+`)
+
+// ApplicationExport is an exported application. It is a valid application manifest, extended with
+// the configuration that is exported for review but not synced by apply, which warns about it.
+type ApplicationExport struct {
+	ApplicationManifest
+	InboundProtocolConfiguration *models.InboundProtocolConfiguration `json:"inboundProtocolConfiguration,omitempty"`
+	AssociatedRoles              *models.AssociatedRoles              `json:"associatedRoles,omitempty"`
+}
+
+// Snapshot holds the exported resources of a tenant, without server generated fields and secrets.
+type Snapshot struct {
+	Applications []ApplicationExport
+	APIResources []APIResourceManifest
+	Warnings     []string
+}
+
+// ExportTenant reads the applications and api resources of the tenant into a snapshot. Other
+// resources are not exported, as apply cannot sync them.
+func ExportTenant(ctx context.Context, cli *CLI) (*Snapshot, error) {
+	snapshot := &Snapshot{}
+
+	apiResources, err := cli.API.APIResource.List(ctx, "BUSINESS")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list api resources: %w", err)
+	}
+	for _, item := range apiResources.APIResources {
+		apiResource, err := cli.API.APIResource.Get(ctx, item.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get api resource %s: %w", item.Identifier, err)
+		}
+		snapshot.APIResources = append(snapshot.APIResources, exportAPIResource(apiResource))
+	}
+	sort.Slice(snapshot.APIResources, func(i, j int) bool {
+		return snapshot.APIResources[i].Identifier < snapshot.APIResources[j].Identifier
+	})
+
+	applications, err := cli.API.Application.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list applications: %w", err)
+	}
+	for _, item := range applications.Applications {
+		if slices.Contains(protectedApplications, item.Name) {
+			continue
+		}
+		application, err := cli.API.Application.Get(ctx, item.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get application %s: %w", item.Name, err)
+		}
+		if err := loadInboundProtocolConfiguration(ctx, cli, application, false); err != nil {
+			return nil, err
+		}
+		export := exportApplication(application)
+		if export.Template == "" {
+			snapshot.Warnings = append(snapshot.Warnings, fmt.Sprintf("the template of application %q could not be detected, set it before applying the snapshot", application.Name))
+		}
+		snapshot.Applications = append(snapshot.Applications, export)
+	}
+	sort.Slice(snapshot.Applications, func(i, j int) bool {
+		return snapshot.Applications[i].Name < snapshot.Applications[j].Name
+	})
+	return snapshot, nil
+}
+
+func exportAPIResource(apiResource *models.APIResource) APIResourceManifest {
+	requiresAuthorization := apiResource.RequiresAuthorization
+	var scopes []models.Scope
+	for _, scope := range apiResource.Scopes {
+		scope.ID = ""
+		scopes = append(scopes, scope)
+	}
+	sort.Slice(scopes, func(i, j int) bool { return scopes[i].Name < scopes[j].Name })
+	return APIResourceManifest{
+		Identifier:            apiResource.Identifier,
+		Name:                  apiResource.Name,
+		Description:           apiResource.Description,
+		RequiresAuthorization: &requiresAuthorization,
+		Scopes:                scopes,
+	}
+}
+
+func exportApplication(application *models.Application) ApplicationExport {
+	export := ApplicationExport{
+		ApplicationManifest: ApplicationManifest{
+			ApplicationCreateInputs: ApplicationCreateInputs{
+				Template: applicationTemplate(application),
+				Name:     application.Name,
+			},
+			Description:            application.Description,
+			AuthenticationSequence: application.AuthenticationSeq,
+			ClaimConfiguration:     application.ClaimConfiguration,
+			AdvancedConfigurations: application.AdvancedConfig,
+		},
+	}
+	if config := application.AdvancedConfig; config != nil {
+		export.SkipLoginConsent = &config.SkipLoginConsent
+		export.SkipLogoutConsent = &config.SkipLogoutConsent
+	}
+	if roles := application.AssociatedRoles; roles != nil {
+		exported := &models.AssociatedRoles{AllowedAudience: roles.AllowedAudience}
+		for _, role := range roles.Roles {
+			exported.Roles = append(exported.Roles, models.AssociatedRole{Name: role.Name})
+		}
+		sort.Slice(exported.Roles, func(i, j int) bool { return exported.Roles[i].Name < exported.Roles[j].Name })
+		export.AssociatedRoles = exported
+	}
+	if config := application.InboundProtocolConfiguration; config != nil {
+		exported := &models.InboundProtocolConfiguration{}
+		if config.OIDC != nil {
+			oidc := *config.OIDC
+			oidc.ClientID = ""
+			oidc.ClientSecret = ""
+			oidc.State = ""
+			exported.OIDC = &oidc
+			export.RedirectURLs = splitCallbackURLs(oidc.CallbackURLs)
+			export.AllowedOrigins = oidc.AllowedOrigins
+		}
+		if config.SAML != nil {
+			exported.SAML = config.SAML
+			export.Issuer = config.SAML.Issuer
+			export.AssertionConsumerURLs = config.SAML.AssertionConsumerURLs
+		}
+		export.InboundProtocolConfiguration = exported
+	}
+	return export
+}
+
+// applicationTemplate detects the template of an application, first from its template ID and
+// then from its inbound protocol configuration.
+func applicationTemplate(application *models.Application) ApplicationTemplate {
+	for template, id := range applicationTemplateIDs {
+		if application.TemplateID == id {
+			return template
+		}
+	}
+	config := application.InboundProtocolConfiguration
+	switch {
+	case config == nil:
+		return ""
+	case config.SAML != nil:
+		return TemplateTraditionalSAML
+	case config.OIDC == nil:
+		return ""
+	case slices.Equal(config.OIDC.GrantTypes, []string{"client_credentials"}):
+		return TemplateM2M
+	case config.OIDC.PublicClient:
+		return TemplateSinglePage
+	}
+	return TemplateTraditionalOIDC
+}
+
+// Write writes the snapshot to the directory as one manifest file per resource, so the directory
+// can be reviewed in a pull request and applied to another tenant. Manifest files left in the
+// directory by a previous export are removed first, so that deleted resources disappear.
+func (s *Snapshot) Write(dir string) ([]string, error) {
+	var files []string
+	write := func(subDir, name string, document interface{}, used map[string]bool) error {
+		file := uniqueFileName(name, used)
+		path := filepath.Join(dir, subDir, file)
+		if err := writeYAMLFile(path, document); err != nil {
+			return err
+		}
+		files = append(files, path)
+		return nil
+	}
+	for _, subDir := range []string{exportAPIResourcesDir, exportApplicationsDir} {
+		if err := resetExportDir(filepath.Join(dir, subDir)); err != nil {
+			return nil, err
+		}
+	}
+	used := map[string]bool{}
+	for _, apiResource := range s.APIResources {
+		document := map[string]interface{}{"apiResources": []APIResourceManifest{apiResource}}
+		if err := write(exportAPIResourcesDir, apiResource.Identifier, document, used); err != nil {
+			return nil, err
+		}
+	}
+	used = map[string]bool{}
+	for _, application := range s.Applications {
+		document := map[string]interface{}{"applications": []ApplicationExport{application}}
+		if err := write(exportApplicationsDir, application.Name, document, used); err != nil {
+			return nil, err
+		}
+	}
+	return files, nil
+}
+
+// resetExportDir creates the directory and removes the manifest files of a previous export.
+func resetExportDir(dir string) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create export directory: %w", err)
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return fmt.Errorf("failed to read export directory: %w", err)
+	}
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".yaml" {
+			continue
+		}
+		if err := os.Remove(filepath.Join(dir, entry.Name())); err != nil {
+			return fmt.Errorf("failed to remove previous export: %w", err)
+		}
+	}
+	return nil
+}
+
+// uniqueFileName turns a resource name into a file name that is not used yet.
+func uniqueFileName(name string, used map[string]bool) string {
+	name = strings.TrimPrefix(strings.TrimPrefix(strings.ToLower(name), "https://"), "http://")
+	base := strings.Trim(unsafeFileNameCharacters.ReplaceAllString(name, "-"), "-")
+	if base == "" {
+		base = "resource"
+	}
+	file := base + ".yaml"
+	for i := 2; used[file]; i++ {
+		file = fmt.Sprintf("%s-%d.yaml", base, i)
+	}
+	used[file] = true
+	return file
+}
+
+// writeYAMLFile writes the document as YAML. The document is converted through JSON first so
+// that the field names follow the json tags, and the keys are sorted and nulls dropped to keep
+// diffs small.
+func writeYAMLFile(path string, document interface{}) error {
+	buffer, err := json.Marshal(document)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s: %w", path, err)
+	}
+	var generic interface{}
+	if err := json.Unmarshal(buffer, &generic); err != nil {
+		return fmt.Errorf("failed to marshal %s: %w", path, err)
+	}
+	dropNulls(generic)
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	defer file.Close()
+	encoder := yaml.NewEncoder(file)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(generic); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return encoder.Close()
+}
+
+// dropNulls removes the null fields of the maps in the value, recursively.
+func dropNulls(value interface{}) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, child := range v {
+			if child == nil {
+				delete(v, key)
+				continue
+			}
+			dropNulls(child)
+		}
+	case []interface{}:
+		for _, child := range v {
+			dropNulls(child)
+		}
+	}
+}
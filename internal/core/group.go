@@ -0,0 +1,206 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/shashimalcse/asgardeo-cli/internal/api"
+	"github.com/shashimalcse/asgardeo-cli/internal/models"
+)
+
+// ResolveGroup finds a group by its ID or by its name.
+func ResolveGroup(ctx context.Context, cli *CLI, idOrName string) (*models.Group, error) {
+	query := api.SCIMQuery{Filter: fmt.Sprintf("displayName eq %s", scimString(idOrName))}
+	groups, err := cli.API.Group.Paginate(query, 2).All(ctx, 2)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search groups: %w", err)
+	}
+	switch len(groups) {
+	case 0:
+	case 1:
+		// The members are not always returned by a filtered list, so the group is read again.
+		return getGroup(ctx, cli, groups[0].ID)
+	default:
+		return nil, fmt.Errorf("more than one group is named %q, use the group ID instead", idOrName)
+	}
+	group, err := getGroup(ctx, cli, idOrName)
+	var apiErr *api.Error
+	if errors.As(err, &apiErr) && apiErr.Status() == http.StatusNotFound {
+		return nil, fmt.Errorf("group not found: %s", idOrName)
+	}
+	return group, err
+}
+
+func getGroup(ctx context.Context, cli *CLI, id string) (*models.Group, error) {
+	group, err := cli.API.Group.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group: %w", err)
+	}
+	return group, nil
+}
+
+// GroupName returns the name of the group without the prefix of its user store, such as DEFAULT/.
+func GroupName(group *models.Group) string {
+	if domain, name, ok := strings.Cut(group.DisplayName, "/"); ok && domain == strings.ToUpper(domain) {
+		return name
+	}
+	return group.DisplayName
+}
+
+// CreateGroup creates a group with the users as its members and returns the created group.
+func CreateGroup(ctx context.Context, cli *CLI, name string, members []string) (*models.Group, error) {
+	if strings.TrimSpace(name) == "" {
+		return nil, fmt.Errorf("group name is required")
+	}
+	users, err := resolveUsers(ctx, cli, members)
+	if err != nil {
+		return nil, err
+	}
+	group := &models.Group{
+		Schemas:     []string{models.SCIMGroupSchema},
+		DisplayName: name,
+		Members:     groupMembers(users),
+	}
+	created, err := cli.API.Group.Create(ctx, group)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create group %s: %w", name, err)
+	}
+	return created, nil
+}
+
+// RenameGroup renames the group and returns the renamed group.
+func RenameGroup(ctx context.Context, cli *CLI, idOrName, name string) (*models.Group, error) {
+	if strings.TrimSpace(name) == "" {
+		return nil, fmt.Errorf("new group name is required")
+	}
+	group, err := ResolveGroup(ctx, cli, idOrName)
+	if err != nil {
+		return nil, err
+	}
+	operations := []models.PatchOperation{{Op: "replace", Value: map[string]interface{}{"displayName": name}}}
+	if _, err := cli.API.Group.Patch(ctx, group.ID, operations); err != nil {
+		return nil, fmt.Errorf("failed to rename group %s: %w", GroupName(group), err)
+	}
+	group.DisplayName = name
+	return group, nil
+}
+
+// DeleteGroup deletes the group and returns the deleted group.
+func DeleteGroup(ctx context.Context, cli *CLI, idOrName string) (*models.Group, error) {
+	group, err := ResolveGroup(ctx, cli, idOrName)
+	if err != nil {
+		return nil, err
+	}
+	if err := cli.API.Group.Delete(ctx, group.ID); err != nil {
+		return nil, fmt.Errorf("failed to delete group %s: %w", GroupName(group), err)
+	}
+	return group, nil
+}
+
+// GroupMembershipChange reports the users whose membership changed, and the users left as they
+// were because they already were, or were not, members of the group.
+type GroupMembershipChange struct {
+	Group     *models.Group
+	Changed   []string
+	Unchanged []string
+}
+
+// AddGroupMembers adds the users, given by ID or username, to the group.
+func AddGroupMembers(ctx context.Context, cli *CLI, idOrName string, members []string) (*GroupMembershipChange, error) {
+	group, users, err := resolveMembership(ctx, cli, idOrName, members)
+	if err != nil {
+		return nil, err
+	}
+	change := &GroupMembershipChange{Group: group}
+	var added []models.User
+	for _, user := range users {
+		if isGroupMember(group, user.ID) {
+			change.Unchanged = append(change.Unchanged, user.UserName)
+			continue
+		}
+		added = append(added, user)
+		change.Changed = append(change.Changed, user.UserName)
+	}
+	if len(added) == 0 {
+		return change, nil
+	}
+	operations := []models.PatchOperation{{Op: "add", Path: "members", Value: groupMembers(added)}}
+	if _, err := cli.API.Group.Patch(ctx, group.ID, operations); err != nil {
+		return nil, fmt.Errorf("failed to add members to group %s: %w", GroupName(group), err)
+	}
+	return change, nil
+}
+
+// RemoveGroupMembers removes the users, given by ID or username, from the group.
+func RemoveGroupMembers(ctx context.Context, cli *CLI, idOrName string, members []string) (*GroupMembershipChange, error) {
+	group, users, err := resolveMembership(ctx, cli, idOrName, members)
+	if err != nil {
+		return nil, err
+	}
+	change := &GroupMembershipChange{Group: group}
+	var operations []models.PatchOperation
+	for _, user := range users {
+		if !isGroupMember(group, user.ID) {
+			change.Unchanged = append(change.Unchanged, user.UserName)
+			continue
+		}
+		operations = append(operations, models.PatchOperation{
+			Op:   "remove",
+			Path: fmt.Sprintf("members[value eq %s]", scimString(user.ID)),
+		})
+		change.Changed = append(change.Changed, user.UserName)
+	}
+	if len(operations) == 0 {
+		return change, nil
+	}
+	if _, err := cli.API.Group.Patch(ctx, group.ID, operations); err != nil {
+		return nil, fmt.Errorf("failed to remove members from group %s: %w", GroupName(group), err)
+	}
+	return change, nil
+}
+
+func resolveMembership(ctx context.Context, cli *CLI, idOrName string, members []string) (*models.Group, []models.User, error) {
+	if len(members) == 0 {
+		return nil, nil, fmt.Errorf("at least one user is required")
+	}
+	group, err := ResolveGroup(ctx, cli, idOrName)
+	if err != nil {
+		return nil, nil, err
+	}
+	users, err := resolveUsers(ctx, cli, members)
+	if err != nil {
+		return nil, nil, err
+	}
+	return group, users, nil
+}
+
+// resolveUsers resolves the users given by ID or username, leaving out duplicates.
+func resolveUsers(ctx context.Context, cli *CLI, idsOrUserNames []string) ([]models.User, error) {
+	var users []models.User
+	for _, idOrUserName := range idsOrUserNames {
+		user, err := ResolveUser(ctx, cli, idOrUserName)
+		if err != nil {
+			return nil, err
+		}
+		if !slices.ContainsFunc(users, func(u models.User) bool { return u.ID == user.ID }) {
+			users = append(users, *user)
+		}
+	}
+	return users, nil
+}
+
+func groupMembers(users []models.User) []models.GroupMember {
+	var members []models.GroupMember
+	for _, user := range users {
+		members = append(members, models.GroupMember{Value: user.ID, Display: user.UserName})
+	}
+	return members
+}
+
+func isGroupMember(group *models.Group, userID string) bool {
+	return slices.ContainsFunc(group.Members, func(member models.GroupMember) bool { return member.Value == userID })
+}
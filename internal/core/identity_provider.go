@@ -0,0 +1,510 @@
+package core
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/shashimalcse/asgardeo-cli/internal/models"
+)
+
+// IdentityProviderTemplate identifies the template an identity provider is created from.
+type IdentityProviderTemplate string
+
+const (
+	IdPTemplateGoogle    IdentityProviderTemplate = "google"
+	IdPTemplateGitHub    IdentityProviderTemplate = "github"
+	IdPTemplateMicrosoft IdentityProviderTemplate = "microsoft"
+	IdPTemplateOIDC      IdentityProviderTemplate = "oidc"
+	IdPTemplateSAML      IdentityProviderTemplate = "saml"
+)
+
+var IdentityProviderTemplates = []IdentityProviderTemplate{
+	IdPTemplateGoogle,
+	IdPTemplateGitHub,
+	IdPTemplateMicrosoft,
+	IdPTemplateOIDC,
+	IdPTemplateSAML,
+}
+
+// identityProviderTemplateIDs maps each template to the ID of the matching console template.
+var identityProviderTemplateIDs = map[IdentityProviderTemplate]string{
+	IdPTemplateGoogle:    "8ea23303-49c0-4253-b81f-82c0fe6fb4a0",
+	IdPTemplateGitHub:    "github-idp",
+	IdPTemplateMicrosoft: "microsoft-idp",
+	IdPTemplateOIDC:      "enterprise-oidc-idp",
+	IdPTemplateSAML:      "enterprise-saml-idp",
+}
+
+// Names of the federated authenticators used by the templates.
+const (
+	AuthenticatorGoogle = "GoogleOIDCAuthenticator"
+	AuthenticatorGitHub = "GithubAuthenticator"
+	AuthenticatorOIDC   = "OpenIDConnectAuthenticator"
+	AuthenticatorSAML   = "SAMLSSOAuthenticator"
+)
+
+// JIT provisioning schemes, selecting what users are asked for when they are provisioned.
+var jitSchemes = []string{
+	"PROVISION_SILENTLY",
+	"PROMPT_USERNAME_PASSWORD_CONSENT",
+	"PROMPT_PASSWORD_CONSENT",
+	"PROMPT_CONSENT",
+}
+
+// IdentityProviderCreateInputs holds the values used to build an identity provider from a template.
+type IdentityProviderCreateInputs struct {
+	Template     IdentityProviderTemplate
+	Name         string
+	Description  string
+	ClientID     string
+	ClientSecret string
+	// Scopes requested from the identity provider. Each template has its own defaults.
+	Scopes []string
+	// MicrosoftTenant is the Azure AD tenant of the microsoft template, common by default.
+	MicrosoftTenant string
+	AuthorizeURL    string
+	TokenURL        string
+	UserInfoURL     string
+	LogoutURL       string
+	JWKSURL         string
+	Issuer          string
+	// SPEntityID is the entity ID the organization uses with the SAML identity provider.
+	SPEntityID   string
+	IdPEntityID  string
+	SSOURL       string
+	MetadataURL  string
+	MetadataFile string
+}
+
+// Validate checks that the fields required by the template are present.
+func (i *IdentityProviderCreateInputs) Validate() error {
+	if strings.TrimSpace(i.Name) == "" {
+		return fmt.Errorf("name is required")
+	}
+	switch i.Template {
+	case IdPTemplateGoogle, IdPTemplateGitHub, IdPTemplateMicrosoft:
+		if i.ClientID == "" || i.ClientSecret == "" {
+			return fmt.Errorf("client ID and client secret are required for the %s template", i.Template)
+		}
+	case IdPTemplateOIDC:
+		if i.ClientID == "" || i.ClientSecret == "" {
+			return fmt.Errorf("client ID and client secret are required for the %s template", i.Template)
+		}
+		if i.AuthorizeURL == "" || i.TokenURL == "" {
+			return fmt.Errorf("authorize URL and token URL are required for the %s template", i.Template)
+		}
+	case IdPTemplateSAML:
+		if i.SPEntityID == "" {
+			return fmt.Errorf("service provider entity ID is required for the %s template", i.Template)
+		}
+		switch {
+		case i.MetadataURL != "" && i.MetadataFile != "":
+			return fmt.Errorf("use either a metadata URL or a metadata file, not both")
+		case i.MetadataURL == "" && i.MetadataFile == "" && (i.IdPEntityID == "" || i.SSOURL == ""):
+			return fmt.Errorf("a metadata URL, a metadata file, or the identity provider entity ID and SSO URL are required for the %s template", i.Template)
+		}
+	case "":
+		return fmt.Errorf("template is required")
+	default:
+		return fmt.Errorf("unsupported template %q, supported templates are %s", i.Template, strings.Join(IdentityProviderTemplateNames(), ", "))
+	}
+	return nil
+}
+
+// CreateIdentityProvider creates an identity provider from a template and returns the created
+// identity provider.
+func CreateIdentityProvider(ctx context.Context, cli *CLI, inputs IdentityProviderCreateInputs) (*models.IdentityProvider, error) {
+	if err := inputs.Validate(); err != nil {
+		return nil, err
+	}
+	tenant, err := cli.Config.GetTenant(cli.Tenant)
+	if err != nil {
+		return nil, err
+	}
+	var metadata []byte
+	switch {
+	case inputs.MetadataFile != "":
+		if metadata, err = os.ReadFile(inputs.MetadataFile); err != nil {
+			return nil, fmt.Errorf("failed to read SAML metadata: %w", err)
+		}
+	case inputs.MetadataURL != "":
+		if metadata, err = fetchSAMLMetadata(ctx, inputs.MetadataURL); err != nil {
+			return nil, err
+		}
+	}
+	if metadata != nil && !strings.Contains(string(metadata), "EntityDescriptor") {
+		return nil, fmt.Errorf("the SAML metadata does not contain an EntityDescriptor")
+	}
+	idp := buildIdentityProvider(inputs, tenant.BaseURL()+"/commonauth", metadata)
+	created, err := cli.API.IDP.Create(ctx, idp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create identity provider %s: %w", inputs.Name, err)
+	}
+	return created, nil
+}
+
+func buildIdentityProvider(inputs IdentityProviderCreateInputs, callbackURL string, metadata []byte) *models.IdentityProvider {
+	idp := &models.IdentityProvider{
+		Name:        inputs.Name,
+		Description: inputs.Description,
+		IsEnabled:   true,
+		TemplateID:  identityProviderTemplateIDs[inputs.Template],
+	}
+	scopes := func(defaults ...string) string {
+		if len(inputs.Scopes) > 0 {
+			return strings.Join(inputs.Scopes, " ")
+		}
+		return strings.Join(defaults, " ")
+	}
+	properties := []models.AuthenticatorProperty{
+		{Key: "ClientId", Value: inputs.ClientID},
+		{Key: "ClientSecret", Value: inputs.ClientSecret},
+		{Key: "callbackUrl", Value: callbackURL},
+	}
+	var authenticator string
+	switch inputs.Template {
+	case IdPTemplateGoogle:
+		authenticator = AuthenticatorGoogle
+		properties = append(properties, models.AuthenticatorProperty{Key: "AdditionalQueryParameters", Value: "scope=" + scopes("email", "openid", "profile")})
+	case IdPTemplateGitHub:
+		authenticator = AuthenticatorGitHub
+		properties = append(properties, models.AuthenticatorProperty{Key: "scope", Value: scopes("user:email")})
+	case IdPTemplateMicrosoft:
+		authenticator = AuthenticatorOIDC
+		microsoftTenant := inputs.MicrosoftTenant
+		if microsoftTenant == "" {
+			microsoftTenant = "common"
+		}
+		endpoint := "https://login.microsoftonline.com/" + microsoftTenant
+		properties = append(properties,
+			models.AuthenticatorProperty{Key: "OAuth2AuthzEPUrl", Value: endpoint + "/oauth2/v2.0/authorize"},
+			models.AuthenticatorProperty{Key: "OAuth2TokenEPUrl", Value: endpoint + "/oauth2/v2.0/token"},
+			models.AuthenticatorProperty{Key: "AdditionalQueryParameters", Value: "scope=" + scopes("openid", "email", "profile")},
+		)
+		idp.Certificate = &models.IdentityProviderCert{JwksURI: endpoint + "/discovery/v2.0/keys"}
+	case IdPTemplateOIDC:
+		authenticator = AuthenticatorOIDC
+		properties = append(properties,
+			models.AuthenticatorProperty{Key: "OAuth2AuthzEPUrl", Value: inputs.AuthorizeURL},
+			models.AuthenticatorProperty{Key: "OAuth2TokenEPUrl", Value: inputs.TokenURL},
+			models.AuthenticatorProperty{Key: "AdditionalQueryParameters", Value: "scope=" + scopes("openid")},
+		)
+		if inputs.UserInfoURL != "" {
+			properties = append(properties, models.AuthenticatorProperty{Key: "UserInfoUrl", Value: inputs.UserInfoURL})
+		}
+		if inputs.LogoutURL != "" {
+			properties = append(properties, models.AuthenticatorProperty{Key: "OIDCLogoutEPUrl", Value: inputs.LogoutURL})
+		}
+		if inputs.JWKSURL != "" {
+			idp.Certificate = &models.IdentityProviderCert{JwksURI: inputs.JWKSURL}
+		}
+		idp.IdpIssuerName = inputs.Issuer
+	case IdPTemplateSAML:
+		authenticator = AuthenticatorSAML
+		properties = []models.AuthenticatorProperty{
+			{Key: "SPEntityId", Value: inputs.SPEntityID},
+			{Key: "NameIDType", Value: "urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified"},
+			{Key: "RequestMethod", Value: "post"},
+		}
+		if metadata != nil {
+			properties = append(properties,
+				models.AuthenticatorProperty{Key: "selectMode", Value: "Metadata File Configuration"},
+				models.AuthenticatorProperty{Key: "meta_data_saml", Value: base64.StdEncoding.EncodeToString(metadata)},
+			)
+		} else {
+			properties = append(properties,
+				models.AuthenticatorProperty{Key: "selectMode", Value: "Manual Configuration"},
+				models.AuthenticatorProperty{Key: "IdPEntityId", Value: inputs.IdPEntityID},
+				models.AuthenticatorProperty{Key: "SSOUrl", Value: inputs.SSOURL},
+			)
+		}
+	}
+	authenticatorID := AuthenticatorID(authenticator)
+	idp.FederatedAuthenticators = &models.FederatedAuthenticators{
+		DefaultAuthenticatorID: authenticatorID,
+		Authenticators: []models.FederatedAuthenticator{{
+			AuthenticatorID: authenticatorID,
+			IsEnabled:       true,
+			IsDefault:       true,
+			Properties:      properties,
+		}},
+	}
+	return idp
+}
+
+// fetchSAMLMetadata downloads the SAML metadata of an identity provider.
+func fetchSAMLMetadata(ctx context.Context, metadataURL string) ([]byte, error) {
+	request, err := http.NewRequestWithContext(ctx, "GET", metadataURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("invalid SAML metadata URL: %w", err)
+	}
+	client := &http.Client{Timeout: 30 * time.Second}
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download SAML metadata: %w", err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to download SAML metadata: %s returned %s", metadataURL, response.Status)
+	}
+	metadata, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download SAML metadata: %w", err)
+	}
+	return metadata, nil
+}
+
+// AuthenticatorID returns the ID of a federated authenticator, which is its name encoded in base64.
+func AuthenticatorID(name string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(name))
+}
+
+// ResolveIdentityProvider finds an identity provider by its ID or by its name.
+func ResolveIdentityProvider(ctx context.Context, cli *CLI, idOrName string) (*models.IdentityProvider, error) {
+	idps, err := cli.API.IDP.Paginate("", 0).All(ctx, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list identity providers: %w", err)
+	}
+	var matches []models.IdentityProvider
+	for _, idp := range idps {
+		if idp.ID == idOrName {
+			return getIdentityProvider(ctx, cli, idp.ID)
+		}
+		if idp.Name == idOrName {
+			matches = append(matches, idp)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("identity provider not found: %s", idOrName)
+	case 1:
+		return getIdentityProvider(ctx, cli, matches[0].ID)
+	default:
+		return nil, fmt.Errorf("more than one identity provider is named %q, use the identity provider ID instead", idOrName)
+	}
+}
+
+func getIdentityProvider(ctx context.Context, cli *CLI, id string) (*models.IdentityProvider, error) {
+	idp, err := cli.API.IDP.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get identity provider: %w", err)
+	}
+	return idp, nil
+}
+
+// IdentityProviderUpdateInputs holds the changes to apply to an identity provider. Nil fields are
+// left unchanged.
+type IdentityProviderUpdateInputs struct {
+	Name        *string
+	Description *string
+	Enabled     *bool
+}
+
+// UpdateIdentityProvider patches the identity provider and returns the updated identity provider.
+func UpdateIdentityProvider(ctx context.Context, cli *CLI, idOrName string, inputs IdentityProviderUpdateInputs) (*models.IdentityProvider, error) {
+	var operations []models.IdentityProviderPatch
+	if inputs.Name != nil {
+		if strings.TrimSpace(*inputs.Name) == "" {
+			return nil, fmt.Errorf("identity provider name cannot be empty")
+		}
+		operations = append(operations, models.IdentityProviderPatch{Operation: "REPLACE", Path: "/name", Value: *inputs.Name})
+	}
+	if inputs.Description != nil {
+		operations = append(operations, models.IdentityProviderPatch{Operation: "REPLACE", Path: "/description", Value: *inputs.Description})
+	}
+	if inputs.Enabled != nil {
+		operations = append(operations, models.IdentityProviderPatch{Operation: "REPLACE", Path: "/isEnabled", Value: *inputs.Enabled})
+	}
+	if len(operations) == 0 {
+		return nil, fmt.Errorf("no changes were given")
+	}
+	idp, err := ResolveIdentityProvider(ctx, cli, idOrName)
+	if err != nil {
+		return nil, err
+	}
+	updated, err := cli.API.IDP.Patch(ctx, idp.ID, operations)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update identity provider %s: %w", idp.Name, err)
+	}
+	if updated == nil {
+		return getIdentityProvider(ctx, cli, idp.ID)
+	}
+	return updated, nil
+}
+
+// DeleteIdentityProvider deletes the identity provider and returns the deleted identity provider.
+func DeleteIdentityProvider(ctx context.Context, cli *CLI, idOrName string) (*models.IdentityProvider, error) {
+	idp, err := ResolveIdentityProvider(ctx, cli, idOrName)
+	if err != nil {
+		return nil, err
+	}
+	if err := cli.API.IDP.Delete(ctx, idp.ID); err != nil {
+		return nil, fmt.Errorf("failed to delete identity provider %s: %w", idp.Name, err)
+	}
+	return idp, nil
+}
+
+// FederatedAuthenticators returns the federated authenticators of the identity provider.
+func FederatedAuthenticators(idp *models.IdentityProvider) []models.FederatedAuthenticator {
+	if idp.FederatedAuthenticators == nil {
+		return nil
+	}
+	return idp.FederatedAuthenticators.Authenticators
+}
+
+// ResolveFederatedAuthenticator finds a federated authenticator of the identity provider by its ID
+// or by its name, and returns it with its properties.
+func ResolveFederatedAuthenticator(ctx context.Context, cli *CLI, idp *models.IdentityProvider, idOrName string) (*models.FederatedAuthenticator, error) {
+	index := slices.IndexFunc(FederatedAuthenticators(idp), func(a models.FederatedAuthenticator) bool {
+		return a.AuthenticatorID == idOrName || strings.EqualFold(a.Name, idOrName)
+	})
+	if index < 0 {
+		return nil, fmt.Errorf("identity provider %s has no federated authenticator %s", idp.Name, idOrName)
+	}
+	authenticator, err := cli.API.IDP.GetFederatedAuthenticator(ctx, idp.ID, idp.FederatedAuthenticators.Authenticators[index].AuthenticatorID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get federated authenticator %s: %w", idOrName, err)
+	}
+	return authenticator, nil
+}
+
+// FederatedAuthenticatorUpdateInputs holds the changes to apply to a federated authenticator. Nil
+// fields are left unchanged.
+type FederatedAuthenticatorUpdateInputs struct {
+	Enabled *bool
+	Default *bool
+	// Properties are set as key=value, replacing the properties with the same key.
+	Properties []string
+	// RemoveProperties are the keys of the properties to remove.
+	RemoveProperties []string
+}
+
+// UpdateFederatedAuthenticator updates the settings and properties of a federated authenticator of
+// the identity provider.
+func UpdateFederatedAuthenticator(ctx context.Context, cli *CLI, idpIDOrName, idOrName string, inputs FederatedAuthenticatorUpdateInputs) (*models.IdentityProvider, *models.FederatedAuthenticator, error) {
+	if inputs.Enabled == nil && inputs.Default == nil && len(inputs.Properties) == 0 && len(inputs.RemoveProperties) == 0 {
+		return nil, nil, fmt.Errorf("no changes were given")
+	}
+	idp, err := ResolveIdentityProvider(ctx, cli, idpIDOrName)
+	if err != nil {
+		return nil, nil, err
+	}
+	authenticator, err := ResolveFederatedAuthenticator(ctx, cli, idp, idOrName)
+	if err != nil {
+		return nil, nil, err
+	}
+	if inputs.Enabled != nil {
+		authenticator.IsEnabled = *inputs.Enabled
+	}
+	if inputs.Default != nil {
+		authenticator.IsDefault = *inputs.Default
+	}
+	for _, property := range inputs.Properties {
+		key, value, ok := strings.Cut(property, "=")
+		if !ok || key == "" {
+			return nil, nil, fmt.Errorf("invalid property %q, use key=value", property)
+		}
+		index := slices.IndexFunc(authenticator.Properties, func(p models.AuthenticatorProperty) bool { return p.Key == key })
+		if index < 0 {
+			authenticator.Properties = append(authenticator.Properties, models.AuthenticatorProperty{Key: key, Value: value})
+			continue
+		}
+		authenticator.Properties[index].Value = value
+	}
+	for _, key := range inputs.RemoveProperties {
+		authenticator.Properties = slices.DeleteFunc(authenticator.Properties, func(p models.AuthenticatorProperty) bool { return p.Key == key })
+	}
+	if err := cli.API.IDP.UpdateFederatedAuthenticator(ctx, idp.ID, authenticator); err != nil {
+		return nil, nil, fmt.Errorf("failed to update federated authenticator %s of identity provider %s: %w", authenticator.Name, idp.Name, err)
+	}
+	return idp, authenticator, nil
+}
+
+// GetJITProvisioning returns the identity provider with its JIT provisioning settings.
+func GetJITProvisioning(ctx context.Context, cli *CLI, idOrName string) (*models.IdentityProvider, *models.JITProvisioning, error) {
+	idp, err := ResolveIdentityProvider(ctx, cli, idOrName)
+	if err != nil {
+		return nil, nil, err
+	}
+	jit, err := cli.API.IDP.GetJIT(ctx, idp.ID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get the JIT provisioning settings of identity provider %s: %w", idp.Name, err)
+	}
+	if jit == nil {
+		jit = &models.JITProvisioning{}
+	}
+	return idp, jit, nil
+}
+
+// JITProvisioningInputs holds the changes to apply to the JIT provisioning settings. Nil fields
+// are left unchanged.
+type JITProvisioningInputs struct {
+	Enabled            *bool
+	Scheme             *string
+	Userstore          *string
+	AssociateLocalUser *bool
+}
+
+// SetJITProvisioning updates the JIT provisioning settings of the identity provider.
+func SetJITProvisioning(ctx context.Context, cli *CLI, idOrName string, inputs JITProvisioningInputs) (*models.IdentityProvider, *models.JITProvisioning, error) {
+	if inputs.Enabled == nil && inputs.Scheme == nil && inputs.Userstore == nil && inputs.AssociateLocalUser == nil {
+		return nil, nil, fmt.Errorf("no changes were given")
+	}
+	var scheme string
+	if inputs.Scheme != nil {
+		scheme = strings.ToUpper(strings.ReplaceAll(*inputs.Scheme, "-", "_"))
+		if !slices.Contains(jitSchemes, scheme) {
+			return nil, nil, fmt.Errorf("invalid scheme %q, supported schemes are %s", *inputs.Scheme, strings.Join(JITSchemeNames(), ", "))
+		}
+	}
+	idp, jit, err := GetJITProvisioning(ctx, cli, idOrName)
+	if err != nil {
+		return nil, nil, err
+	}
+	if inputs.Enabled != nil {
+		jit.IsEnabled = *inputs.Enabled
+	}
+	if inputs.Scheme != nil {
+		jit.Scheme = scheme
+	}
+	if inputs.Userstore != nil {
+		jit.Userstore = *inputs.Userstore
+	}
+	if inputs.AssociateLocalUser != nil {
+		jit.AssociateLocalUser = *inputs.AssociateLocalUser
+	}
+	if jit.Scheme == "" {
+		jit.Scheme = jitSchemes[0]
+	}
+	if jit.Userstore == "" {
+		jit.Userstore = cli.PrimaryUserstore()
+	}
+	if err := cli.API.IDP.UpdateJIT(ctx, idp.ID, jit); err != nil {
+		return nil, nil, fmt.Errorf("failed to update the JIT provisioning settings of identity provider %s: %w", idp.Name, err)
+	}
+	return idp, jit, nil
+}
+
+// JITSchemeNames returns the JIT provisioning schemes as they are given on the command line.
+func JITSchemeNames() []string {
+	var names []string
+	for _, scheme := range jitSchemes {
+		names = append(names, strings.ToLower(strings.ReplaceAll(scheme, "_", "-")))
+	}
+	return names
+}
+
+func IdentityProviderTemplateNames() []string {
+	var names []string
+	for _, template := range IdentityProviderTemplates {
+		names = append(names, string(template))
+	}
+	return names
+}
@@ -7,12 +7,14 @@ import (
 	"github.com/shashimalcse/asgardeo-cli/internal/auth"
 	"github.com/shashimalcse/asgardeo-cli/internal/config"
 	"github.com/shashimalcse/asgardeo-cli/internal/keyring"
+	"go.uber.org/zap"
 )
 
 type LoginInputs struct {
 	ClientID     string
 	ClientSecret string
 	Tenant       string
+	Server       string
 }
 
 func (i *LoginInputs) IsLoggingInAsAMachine() bool {
@@ -21,25 +23,28 @@ func (i *LoginInputs) IsLoggingInAsAMachine() bool {
 
 func AuthenticateWithClientCredentials(inputs LoginInputs, cli *CLI) error {
 
-	result, err := auth.AuthenticateWithClientCredentials(http.DefaultClient, auth.ClientCredentials{ClientID: inputs.ClientID, ClientSecret: inputs.ClientSecret, Tenant: inputs.Tenant})
+	server, err := resolveServer(inputs.Server, cli)
+	if err != nil {
+		return err
+	}
+	result, err := auth.AuthenticateWithClientCredentials(http.DefaultClient, auth.ClientCredentials{ClientID: inputs.ClientID, ClientSecret: inputs.ClientSecret, Tenant: inputs.Tenant, Server: server})
 	if err != nil {
 		return err
 	}
-	expireIn := time.Now().Add(time.Duration(result.ExpiresIn) * time.Second)
 	tenant := config.Tenant{
-		Name:      inputs.Tenant,
-		ClientID:  inputs.ClientID,
-		ExpiresIn: expireIn,
+		Name:     inputs.Tenant,
+		ClientID: inputs.ClientID,
+		Server:   server,
 	}
-	if err := keyring.StoreAccessToken(inputs.Tenant, result.AccessToken); err != nil {
-		tenant.AccessToken = result.AccessToken
+	// The client secret is kept so that the access token can be renewed once it expires.
+	if err := keyring.StoreClientSecret(inputs.Tenant, inputs.ClientSecret); err != nil {
+		cli.Logger.Warn("Failed to store the client secret in the keyring, token renewal will not be possible", zap.Error(err))
 	}
-	err = cli.Config.AddTenant(tenant)
+	err = saveTenantTokens(cli, tenant, result)
 	if err != nil {
 		return err
 	}
-	cli.Config.DefaultTenant = tenant.Name
-	return nil
+	return cli.Config.SetDefaultTenant(tenant.Name)
 }
 
 func GetDeviceCode() (auth.State, error) {
@@ -59,13 +64,38 @@ func GetAccessTokenFromDeviceCode(cli *CLI, state auth.State) error {
 	}
 	tenant := config.Tenant{Name: "carbon.super",
 		ClientID: "Wkwv5_jmo2DJVoul3bW7qve46C4a"}
-	if err := keyring.StoreAccessToken("carbon.super", result.AccessToken); err != nil {
-		tenant.AccessToken = result.AccessToken
-	}
-	err = cli.Config.AddTenant(tenant)
+	err = saveTenantTokens(cli, tenant, result)
 	if err != nil {
 		return err
 	}
-	cli.Config.DefaultTenant = tenant.Name
-	return nil
+	return cli.Config.SetDefaultTenant(tenant.Name)
+}
+
+// resolveServer returns the normalized server to log in to. When no server is given,
+// the server from the config file is used, followed by the default server.
+func resolveServer(server string, cli *CLI) (string, error) {
+	if server == "" {
+		if err := cli.Config.Initialize(); err != nil {
+			return "", err
+		}
+		server = cli.Config.GetServer()
+	}
+	return config.NormalizeServer(server)
+}
+
+// saveTenantTokens stores the tokens of an authentication result in the keyring and
+// persists the tenant. Tokens are kept in the config file only when the keyring is unavailable.
+func saveTenantTokens(cli *CLI, tenant config.Tenant, result auth.Result) error {
+	tenant.ExpiresIn = time.Now().Add(time.Duration(result.ExpiresIn) * time.Second)
+	tenant.AccessToken = ""
+	if err := keyring.StoreAccessToken(tenant.Name, result.AccessToken); err != nil {
+		tenant.AccessToken = result.AccessToken
+	}
+	if result.RefreshToken != "" {
+		tenant.RefreshToken = ""
+		if err := keyring.StoreRefreshToken(tenant.Name, result.RefreshToken); err != nil {
+			tenant.RefreshToken = result.RefreshToken
+		}
+	}
+	return cli.Config.AddTenant(tenant)
 }
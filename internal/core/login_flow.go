@@ -0,0 +1,289 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/shashimalcse/asgardeo-cli/internal/models"
+	"gopkg.in/yaml.v3"
+)
+
+const localIdP = "LOCAL"
+
+// localAuthenticatorAliases maps the short names accepted in login flows to the names of the
+// local authenticators.
+var localAuthenticatorAliases = map[string]string{
+	"basic":            "BasicAuthenticator",
+	"totp":             "totp",
+	"email-otp":        "email-otp-authenticator",
+	"sms-otp":          "sms-otp-authenticator",
+	"magic-link":       "MagicLinkAuthenticator",
+	"passkey":          "FIDOAuthenticator",
+	"backup-code":      "backup-code-authenticator",
+	"identifier-first": "IdentifierExecutor",
+}
+
+// LoginFlow is the login flow of an application as it is shown and edited on the command line.
+// Local authenticators are given by name or alias, and identity providers by name with an
+// optional federated authenticator.
+type LoginFlow struct {
+	Steps []LoginFlowStep `json:"steps"`
+	// SubjectStep is the step the user identity is taken from, the first step by default.
+	SubjectStep int `json:"subjectStep,omitempty"`
+	// AttributeStep is the step the user attributes are taken from, the first step by default.
+	AttributeStep int `json:"attributeStep,omitempty"`
+}
+
+type LoginFlowStep struct {
+	Options []LoginFlowOption `json:"options"`
+}
+
+type LoginFlowOption struct {
+	Authenticator string `json:"authenticator,omitempty"`
+	IDP           string `json:"idp,omitempty"`
+}
+
+// ReadLoginFlow reads a login flow from YAML or JSON.
+func ReadLoginFlow(r io.Reader) (*LoginFlow, error) {
+	// JSON is a subset of YAML, so the flow is read with the YAML decoder and then mapped through
+	// JSON to honour the json tags.
+	var document interface{}
+	if err := yaml.NewDecoder(r).Decode(&document); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("the login flow is empty")
+		}
+		return nil, fmt.Errorf("failed to parse login flow: %w", err)
+	}
+	buffer, err := json.Marshal(document)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse login flow: %w", err)
+	}
+	var flow LoginFlow
+	decoder := json.NewDecoder(bytes.NewReader(buffer))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&flow); err != nil {
+		return nil, fmt.Errorf("failed to parse login flow: %w", err)
+	}
+	return &flow, nil
+}
+
+// ParseLoginFlowSteps builds a login flow from steps given as comma separated authenticators or
+// identity providers, for example "basic,Google".
+func ParseLoginFlowSteps(steps []string) *LoginFlow {
+	flow := &LoginFlow{}
+	for _, step := range steps {
+		var options []LoginFlowOption
+		for _, option := range strings.Split(step, ",") {
+			if option = strings.TrimSpace(option); option != "" {
+				options = append(options, LoginFlowOption{Authenticator: option})
+			}
+		}
+		flow.Steps = append(flow.Steps, LoginFlowStep{Options: options})
+	}
+	return flow
+}
+
+// GetLoginFlow returns the application with its login flow.
+func GetLoginFlow(ctx context.Context, cli *CLI, idOrName string) (*models.Application, *LoginFlow, error) {
+	application, err := ResolveApplication(ctx, cli, idOrName)
+	if err != nil {
+		return nil, nil, err
+	}
+	return application, loginFlow(application.AuthenticationSeq), nil
+}
+
+func loginFlow(sequence *models.AuthenticationSequence) *LoginFlow {
+	flow := &LoginFlow{}
+	if sequence == nil {
+		return flow
+	}
+	flow.SubjectStep = sequence.SubjectStepID
+	flow.AttributeStep = sequence.AttributeStepID
+	for _, step := range sequence.Steps {
+		var options []LoginFlowOption
+		for _, option := range step.Options {
+			if option.IDP == localIdP {
+				options = append(options, LoginFlowOption{Authenticator: option.Authenticator})
+				continue
+			}
+			options = append(options, LoginFlowOption{Authenticator: option.Authenticator, IDP: option.IDP})
+		}
+		flow.Steps = append(flow.Steps, LoginFlowStep{Options: options})
+	}
+	return flow
+}
+
+// SetLoginFlow replaces the login flow of the application. The authenticators and identity
+// providers of the flow are checked against the ones of the organization before it is saved, and
+// the conditional authentication script of the application is kept.
+func SetLoginFlow(ctx context.Context, cli *CLI, idOrName string, flow *LoginFlow) (*models.Application, *LoginFlow, error) {
+	if len(flow.Steps) == 0 {
+		return nil, nil, fmt.Errorf("the login flow requires at least one step")
+	}
+	subjectStep, attributeStep := max(flow.SubjectStep, 1), max(flow.AttributeStep, 1)
+	if subjectStep > len(flow.Steps) || attributeStep > len(flow.Steps) {
+		return nil, nil, fmt.Errorf("the subject and attribute steps must be between 1 and %d", len(flow.Steps))
+	}
+	application, err := ResolveApplication(ctx, cli, idOrName)
+	if err != nil {
+		return nil, nil, err
+	}
+	resolver, err := newLoginFlowResolver(ctx, cli)
+	if err != nil {
+		return nil, nil, err
+	}
+	sequence := &models.AuthenticationSequence{
+		Type:                      "USER_DEFINED",
+		RequestPathAuthenticators: []interface{}{},
+		SubjectStepID:             subjectStep,
+		AttributeStepID:           attributeStep,
+	}
+	if current := application.AuthenticationSeq; current != nil {
+		sequence.Script = current.Script
+		if current.RequestPathAuthenticators != nil {
+			sequence.RequestPathAuthenticators = current.RequestPathAuthenticators
+		}
+	}
+	for i, step := range flow.Steps {
+		if len(step.Options) == 0 {
+			return nil, nil, fmt.Errorf("step %d of the login flow has no authenticators", i+1)
+		}
+		resolved := models.Step{ID: i + 1}
+		for _, option := range step.Options {
+			resolvedOption, err := resolver.resolve(ctx, option)
+			if err != nil {
+				return nil, nil, fmt.Errorf("step %d: %w", i+1, err)
+			}
+			if slices.Contains(resolved.Options, *resolvedOption) {
+				return nil, nil, fmt.Errorf("step %d: %s is given more than once", i+1, resolvedOption.Authenticator)
+			}
+			resolved.Options = append(resolved.Options, *resolvedOption)
+		}
+		sequence.Steps = append(sequence.Steps, resolved)
+	}
+	patch := map[string]interface{}{"authenticationSequence": sequence}
+	if err := cli.API.Application.Patch(ctx, application.ID, patch); err != nil {
+		return nil, nil, fmt.Errorf("failed to update the login flow of application %s: %w", application.Name, err)
+	}
+	application.AuthenticationSeq = sequence
+	return application, loginFlow(sequence), nil
+}
+
+// loginFlowResolver resolves the options of a login flow against the local authenticators and
+// the identity providers of the organization.
+type loginFlowResolver struct {
+	cli            *CLI
+	authenticators []models.Authenticator
+	idps           []models.IdentityProvider
+	details        map[string]*models.IdentityProvider
+}
+
+func newLoginFlowResolver(ctx context.Context, cli *CLI) (*loginFlowResolver, error) {
+	authenticators, err := cli.API.Authenticator.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list authenticators: %w", err)
+	}
+	idps, err := cli.API.IDP.Paginate("", 0).All(ctx, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list identity providers: %w", err)
+	}
+	return &loginFlowResolver{cli: cli, authenticators: authenticators, idps: idps, details: map[string]*models.IdentityProvider{}}, nil
+}
+
+func (r *loginFlowResolver) resolve(ctx context.Context, option LoginFlowOption) (*models.Options, error) {
+	if option.IDP != "" && option.IDP != localIdP {
+		return r.resolveFederated(ctx, option.IDP, option.Authenticator)
+	}
+	if option.Authenticator == "" {
+		return nil, fmt.Errorf("an authenticator or an identity provider is required")
+	}
+	name := option.Authenticator
+	if alias, ok := localAuthenticatorAliases[strings.ToLower(name)]; ok {
+		name = alias
+	}
+	for _, authenticator := range r.authenticators {
+		if !strings.EqualFold(authenticator.Type, localIdP) {
+			continue
+		}
+		if strings.EqualFold(authenticator.Name, name) || strings.EqualFold(authenticator.DisplayName, name) {
+			if !authenticator.IsEnabled {
+				return nil, fmt.Errorf("authenticator %s is disabled in the organization", authenticator.Name)
+			}
+			return &models.Options{IDP: localIdP, Authenticator: authenticator.Name}, nil
+		}
+	}
+	// Steps given on the command line name identity providers the same way as authenticators.
+	if option.IDP == "" && slices.ContainsFunc(r.idps, func(idp models.IdentityProvider) bool { return idp.Name == option.Authenticator }) {
+		return r.resolveFederated(ctx, option.Authenticator, "")
+	}
+	return nil, fmt.Errorf("unknown authenticator %q, available authenticators are %s", option.Authenticator, strings.Join(r.names(), ", "))
+}
+
+func (r *loginFlowResolver) resolveFederated(ctx context.Context, idpName, authenticatorName string) (*models.Options, error) {
+	index := slices.IndexFunc(r.idps, func(idp models.IdentityProvider) bool { return idp.Name == idpName })
+	if index < 0 {
+		return nil, fmt.Errorf("identity provider not found: %s", idpName)
+	}
+	idp, ok := r.details[r.idps[index].ID]
+	if !ok {
+		var err error
+		if idp, err = getIdentityProvider(ctx, r.cli, r.idps[index].ID); err != nil {
+			return nil, err
+		}
+		r.details[idp.ID] = idp
+	}
+	if !idp.IsEnabled {
+		return nil, fmt.Errorf("identity provider %s is disabled", idp.Name)
+	}
+	authenticators := FederatedAuthenticators(idp)
+	var match *models.FederatedAuthenticator
+	for i, authenticator := range authenticators {
+		if authenticatorName == "" && authenticator.AuthenticatorID == idp.FederatedAuthenticators.DefaultAuthenticatorID ||
+			authenticatorName != "" && (strings.EqualFold(authenticator.Name, authenticatorName) || authenticator.AuthenticatorID == authenticatorName) {
+			match = &authenticators[i]
+			break
+		}
+	}
+	switch {
+	case match == nil && authenticatorName == "":
+		return nil, fmt.Errorf("identity provider %s has no default federated authenticator", idp.Name)
+	case match == nil:
+		return nil, fmt.Errorf("identity provider %s has no federated authenticator %s", idp.Name, authenticatorName)
+	case !match.IsEnabled:
+		return nil, fmt.Errorf("federated authenticator %s of identity provider %s is disabled", match.Name, idp.Name)
+	}
+	return &models.Options{IDP: idp.Name, Authenticator: match.Name}, nil
+}
+
+// names returns the enabled local authenticators, by alias when they have one, and the enabled
+// identity providers.
+func (r *loginFlowResolver) names() []string {
+	aliases := map[string]string{}
+	for alias, name := range localAuthenticatorAliases {
+		aliases[name] = alias
+	}
+	var names []string
+	for _, authenticator := range r.authenticators {
+		if !authenticator.IsEnabled || !strings.EqualFold(authenticator.Type, localIdP) {
+			continue
+		}
+		if alias, ok := aliases[authenticator.Name]; ok {
+			names = append(names, alias)
+			continue
+		}
+		names = append(names, authenticator.Name)
+	}
+	slices.Sort(names)
+	for _, idp := range r.idps {
+		if idp.IsEnabled {
+			names = append(names, idp.Name)
+		}
+	}
+	return names
+}
@@ -0,0 +1,175 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/shashimalcse/asgardeo-cli/internal/api"
+	"github.com/shashimalcse/asgardeo-cli/internal/models"
+)
+
+// OIDCClaimDialectURI is the URI of the dialect of the claims OIDC scopes release.
+const OIDCClaimDialectURI = "http://wso2.org/oidc/claim"
+
+// ResolveOIDCScope finds an OIDC scope by its name.
+func ResolveOIDCScope(ctx context.Context, cli *CLI, name string) (*models.OIDCScope, error) {
+	scope, err := cli.API.OIDCScope.Get(ctx, name)
+	var apiErr *api.Error
+	if errors.As(err, &apiErr) && apiErr.Status() == http.StatusNotFound {
+		return nil, fmt.Errorf("OIDC scope not found: %s", name)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get OIDC scope: %w", err)
+	}
+	return scope, nil
+}
+
+// OIDCScopeCreateInputs holds the values of a new OIDC scope.
+type OIDCScopeCreateInputs struct {
+	Name        string
+	DisplayName string
+	Description string
+	// Claims are the claims of the OIDC dialect released with the scope, such as email.
+	Claims []string
+}
+
+// CreateOIDCScope creates an OIDC scope and returns the created scope. The claims are checked
+// against the OIDC dialect before the scope is created.
+func CreateOIDCScope(ctx context.Context, cli *CLI, inputs OIDCScopeCreateInputs) (*models.OIDCScope, error) {
+	if strings.TrimSpace(inputs.Name) == "" {
+		return nil, fmt.Errorf("OIDC scope name is required")
+	}
+	if strings.ContainsFunc(inputs.Name, func(r rune) bool { return r == ' ' || r == '\t' }) {
+		return nil, fmt.Errorf("invalid OIDC scope name %q, scope names cannot contain spaces", inputs.Name)
+	}
+	if inputs.DisplayName == "" {
+		inputs.DisplayName = inputs.Name
+	}
+	claims := uniqueClaims(inputs.Claims)
+	if err := checkOIDCClaims(ctx, cli, claims); err != nil {
+		return nil, err
+	}
+	scope := &models.OIDCScope{
+		Name:        inputs.Name,
+		DisplayName: inputs.DisplayName,
+		Description: inputs.Description,
+		Claims:      claims,
+	}
+	if err := cli.API.OIDCScope.Create(ctx, scope); err != nil {
+		return nil, fmt.Errorf("failed to create OIDC scope %s: %w", inputs.Name, err)
+	}
+	return scope, nil
+}
+
+// OIDCScopeUpdateInputs holds the changes to apply to an OIDC scope. Nil fields are left unchanged.
+type OIDCScopeUpdateInputs struct {
+	DisplayName *string
+	Description *string
+	// Claims replaces the claims of the scope.
+	Claims *[]string
+	// AddClaims and RemoveClaims add claims to and remove claims from the scope.
+	AddClaims    []string
+	RemoveClaims []string
+}
+
+// UpdateOIDCScope updates the OIDC scope and returns the updated scope. Added claims are checked
+// against the OIDC dialect before the scope is updated.
+func UpdateOIDCScope(ctx context.Context, cli *CLI, name string, inputs OIDCScopeUpdateInputs) (*models.OIDCScope, error) {
+	if inputs.DisplayName == nil && inputs.Description == nil && inputs.Claims == nil && len(inputs.AddClaims) == 0 && len(inputs.RemoveClaims) == 0 {
+		return nil, fmt.Errorf("no changes were given")
+	}
+	if inputs.Claims != nil && (len(inputs.AddClaims) > 0 || len(inputs.RemoveClaims) > 0) {
+		return nil, fmt.Errorf("the claims of a scope are either replaced or changed, not both")
+	}
+	scope, err := ResolveOIDCScope(ctx, cli, name)
+	if err != nil {
+		return nil, err
+	}
+	if inputs.DisplayName != nil {
+		if strings.TrimSpace(*inputs.DisplayName) == "" {
+			return nil, fmt.Errorf("OIDC scope display name cannot be empty")
+		}
+		scope.DisplayName = *inputs.DisplayName
+	}
+	if inputs.Description != nil {
+		scope.Description = *inputs.Description
+	}
+	var added []string
+	switch {
+	case inputs.Claims != nil:
+		scope.Claims = uniqueClaims(*inputs.Claims)
+		added = scope.Claims
+	default:
+		for _, claim := range inputs.RemoveClaims {
+			if !slices.Contains(scope.Claims, claim) {
+				return nil, fmt.Errorf("claim %s is not released with OIDC scope %s", claim, scope.Name)
+			}
+		}
+		scope.Claims = slices.DeleteFunc(scope.Claims, func(claim string) bool { return slices.Contains(inputs.RemoveClaims, claim) })
+		for _, claim := range uniqueClaims(inputs.AddClaims) {
+			if !slices.Contains(scope.Claims, claim) {
+				scope.Claims = append(scope.Claims, claim)
+				added = append(added, claim)
+			}
+		}
+	}
+	if err := checkOIDCClaims(ctx, cli, added); err != nil {
+		return nil, err
+	}
+	if scope.Claims == nil {
+		scope.Claims = []string{}
+	}
+	update := &models.OIDCScope{DisplayName: scope.DisplayName, Description: scope.Description, Claims: scope.Claims}
+	if err := cli.API.OIDCScope.Update(ctx, scope.Name, update); err != nil {
+		return nil, fmt.Errorf("failed to update OIDC scope %s: %w", scope.Name, err)
+	}
+	return scope, nil
+}
+
+// DeleteOIDCScope deletes the OIDC scope and returns the deleted scope.
+func DeleteOIDCScope(ctx context.Context, cli *CLI, name string) (*models.OIDCScope, error) {
+	scope, err := ResolveOIDCScope(ctx, cli, name)
+	if err != nil {
+		return nil, err
+	}
+	if err := cli.API.OIDCScope.Delete(ctx, scope.Name); err != nil {
+		return nil, fmt.Errorf("failed to delete OIDC scope %s: %w", scope.Name, err)
+	}
+	return scope, nil
+}
+
+// checkOIDCClaims checks that the claims are claims of the OIDC dialect, which the server does
+// not do when a scope is saved.
+func checkOIDCClaims(ctx context.Context, cli *CLI, claims []string) error {
+	if len(claims) == 0 {
+		return nil
+	}
+	_, oidcClaims, err := ListExternalClaims(ctx, cli, OIDCClaimDialectURI)
+	if err != nil {
+		return err
+	}
+	var missing []string
+	for _, claim := range claims {
+		if !slices.ContainsFunc(oidcClaims, func(oidcClaim models.ExternalClaim) bool { return oidcClaim.ClaimURI == claim }) {
+			missing = append(missing, claim)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("claims not found in the OIDC dialect: %s, map them with 'asgardeo claims mappings create oidc <claim> --local-claim <uri>'", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+func uniqueClaims(claims []string) []string {
+	unique := []string{}
+	for _, claim := range claims {
+		if claim = strings.TrimSpace(claim); claim != "" && !slices.Contains(unique, claim) {
+			unique = append(unique, claim)
+		}
+	}
+	return unique
+}
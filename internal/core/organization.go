@@ -0,0 +1,167 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/shashimalcse/asgardeo-cli/internal/auth"
+	"github.com/shashimalcse/asgardeo-cli/internal/config"
+	"github.com/shashimalcse/asgardeo-cli/internal/keyring"
+	"github.com/shashimalcse/asgardeo-cli/internal/models"
+	"go.uber.org/zap"
+)
+
+// ResolveOrganization finds an organization of the tenant by its ID or name.
+func ResolveOrganization(ctx context.Context, cli *CLI, idOrName string) (*models.Organization, error) {
+	organizations, err := cli.API.Organization.List(ctx, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list organizations: %w", err)
+	}
+	organization, err := matchOrganization(organizations, idOrName)
+	if err != nil {
+		return nil, err
+	}
+	return cli.API.Organization.Get(ctx, organization.ID)
+}
+
+// matchOrganization finds an organization by its ID or name among the listed organizations.
+func matchOrganization(organizations []models.Organization, idOrName string) (*models.Organization, error) {
+	var matches []models.Organization
+	for _, organization := range organizations {
+		if organization.ID == idOrName {
+			return &organization, nil
+		}
+		if organization.Name == idOrName {
+			matches = append(matches, organization)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("organization not found: %s", idOrName)
+	case 1:
+		return &matches[0], nil
+	default:
+		return nil, fmt.Errorf("more than one organization is named %q, use the organization ID instead", idOrName)
+	}
+}
+
+// CurrentOrganization returns the organization the tenant is switched to, or nil when the commands
+// run against the root organization.
+func CurrentOrganization(cli *CLI) (*config.Organization, error) {
+	tenant, err := cli.Config.GetTenant(cli.Tenant)
+	if err != nil {
+		return nil, err
+	}
+	return tenant.Organization, nil
+}
+
+// OrganizationCreateInputs holds the values of a new organization.
+type OrganizationCreateInputs struct {
+	Name        string
+	Description string
+	// Parent is the ID or name of the parent organization. The organization is created under the
+	// root organization when it is empty.
+	Parent string
+}
+
+// CreateOrganization creates an organization and returns the created organization.
+func CreateOrganization(ctx context.Context, cli *CLI, inputs OrganizationCreateInputs) (*models.Organization, error) {
+	if strings.TrimSpace(inputs.Name) == "" {
+		return nil, fmt.Errorf("organization name is required")
+	}
+	organization := &models.OrganizationCreate{Name: inputs.Name, Description: inputs.Description}
+	if inputs.Parent != "" {
+		parent, err := ResolveOrganization(ctx, cli, inputs.Parent)
+		if err != nil {
+			return nil, err
+		}
+		organization.ParentID = parent.ID
+	}
+	created, err := cli.API.Organization.Create(ctx, organization)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create organization %s: %w", inputs.Name, err)
+	}
+	return created, nil
+}
+
+// DeleteOrganization deletes the organization and returns the deleted organization. The tenant is
+// switched back to the root organization when it was switched to the deleted one.
+func DeleteOrganization(ctx context.Context, cli *CLI, idOrName string) (*models.Organization, error) {
+	organization, err := ResolveOrganization(ctx, cli, idOrName)
+	if err != nil {
+		return nil, err
+	}
+	if err := cli.API.Organization.Delete(ctx, organization.ID); err != nil {
+		return nil, fmt.Errorf("failed to delete organization %s: %w", organization.Name, err)
+	}
+	current, err := CurrentOrganization(cli)
+	if err != nil {
+		return nil, err
+	}
+	if current != nil && current.ID == organization.ID {
+		if err := SwitchToRootOrganization(cli); err != nil {
+			return nil, err
+		}
+	}
+	return organization, nil
+}
+
+// SwitchOrganization exchanges the access token of the tenant for an access token of the
+// organization, so that the following commands run against the organization.
+func SwitchOrganization(ctx context.Context, cli *CLI, idOrName string) (*models.Organization, error) {
+	organization, err := ResolveOrganization(ctx, cli, idOrName)
+	if err != nil {
+		return nil, err
+	}
+	tenant, err := cli.Config.GetTenant(cli.Tenant)
+	if err != nil {
+		return nil, err
+	}
+	if err := switchOrganization(cli, tenant, config.Organization{ID: organization.ID, Name: organization.Name}); err != nil {
+		return nil, fmt.Errorf("failed to switch to organization %s: %w", organization.Name, err)
+	}
+	return organization, nil
+}
+
+// SwitchToRootOrganization makes the following commands run against the root organization again.
+func SwitchToRootOrganization(cli *CLI) error {
+	tenant, err := cli.Config.GetTenant(cli.Tenant)
+	if err != nil {
+		return err
+	}
+	if err := keyring.DeleteOrganizationAccessToken(tenant.Name); err != nil {
+		cli.Logger.Warn("Failed to delete the organization access token from the keyring", zap.Error(err))
+	}
+	tenant.Organization = nil
+	return cli.Config.AddTenant(tenant)
+}
+
+// switchOrganization exchanges the access token of the tenant for an access token of the
+// organization and persists the organization with the tenant.
+func switchOrganization(cli *CLI, tenant config.Tenant, organization config.Organization) error {
+	secret, err := keyring.GetClientSecret(tenant.Name)
+	if err != nil {
+		secret = ""
+	}
+	result, err := auth.SwitchOrganization(http.DefaultClient, auth.OrganizationSwitchCredentials{
+		ClientID:     tenant.ClientID,
+		ClientSecret: secret,
+		AccessToken:  tenant.GetAccessToken(),
+		Organization: organization.ID,
+		Tenant:       tenant.Name,
+		Server:       tenant.GetServer(),
+	})
+	if err != nil {
+		return err
+	}
+	organization.ExpiresIn = time.Now().Add(time.Duration(result.ExpiresIn) * time.Second)
+	organization.AccessToken = ""
+	if err := keyring.StoreOrganizationAccessToken(tenant.Name, result.AccessToken); err != nil {
+		organization.AccessToken = result.AccessToken
+	}
+	tenant.Organization = &organization
+	return cli.Config.AddTenant(tenant)
+}
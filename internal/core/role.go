@@ -0,0 +1,351 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/shashimalcse/asgardeo-cli/internal/api"
+	"github.com/shashimalcse/asgardeo-cli/internal/models"
+)
+
+const (
+	RoleAudienceOrganization = "organization"
+	RoleAudienceApplication  = "application"
+)
+
+// ResolveRole finds a role by its ID or by its name.
+func ResolveRole(ctx context.Context, cli *CLI, idOrName string) (*models.RoleV2, error) {
+	query := api.SCIMQuery{Filter: fmt.Sprintf("displayName eq %s", scimString(idOrName))}
+	roles, err := cli.API.Role.Paginate(query, 2).All(ctx, 2)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search roles: %w", err)
+	}
+	switch len(roles) {
+	case 0:
+	case 1:
+		// The permissions and assignments are not returned by a filtered list, so the role is read again.
+		return getRole(ctx, cli, roles[0].ID)
+	default:
+		return nil, fmt.Errorf("more than one role is named %q, use the role ID instead", idOrName)
+	}
+	role, err := getRole(ctx, cli, idOrName)
+	var apiErr *api.Error
+	if errors.As(err, &apiErr) && apiErr.Status() == http.StatusNotFound {
+		return nil, fmt.Errorf("role not found: %s", idOrName)
+	}
+	return role, err
+}
+
+func getRole(ctx context.Context, cli *CLI, id string) (*models.RoleV2, error) {
+	role, err := cli.API.Role.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get role: %w", err)
+	}
+	return role, nil
+}
+
+// RoleAudience returns the audience of the role as organization or application:<name>.
+func RoleAudience(role *models.RoleV2) string {
+	if role.Audience == nil || strings.EqualFold(role.Audience.Type, RoleAudienceOrganization) {
+		return RoleAudienceOrganization
+	}
+	return strings.ToLower(role.Audience.Type) + ":" + role.Audience.Display
+}
+
+// RoleCreateInputs holds the values of a new role.
+type RoleCreateInputs struct {
+	Name string
+	// Audience is organization, or application:<id|name> for a role of an application.
+	Audience string
+	// Permissions are the scopes granted by the role, as <api-identifier>:<scope>.
+	Permissions []string
+}
+
+// CreateRole creates a role and returns the created role.
+func CreateRole(ctx context.Context, cli *CLI, inputs RoleCreateInputs) (*models.RoleV2, error) {
+	if strings.TrimSpace(inputs.Name) == "" {
+		return nil, fmt.Errorf("role name is required")
+	}
+	audience, err := resolveRoleAudience(ctx, cli, inputs.Audience)
+	if err != nil {
+		return nil, err
+	}
+	permissions, err := resolvePermissions(ctx, cli, inputs.Permissions)
+	if err != nil {
+		return nil, err
+	}
+	role := &models.RoleV2{
+		Schemas:     []string{models.SCIMRoleSchema},
+		DisplayName: inputs.Name,
+		Audience:    audience,
+		Permissions: permissions,
+	}
+	created, err := cli.API.Role.Create(ctx, role)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create role %s: %w", inputs.Name, err)
+	}
+	return created, nil
+}
+
+// resolveRoleAudience resolves the audience of a new role. Roles of the organization are created
+// without an audience, which the server defaults to the organization.
+func resolveRoleAudience(ctx context.Context, cli *CLI, audience string) (*models.RoleAudience, error) {
+	audienceType, value, _ := strings.Cut(audience, ":")
+	switch strings.ToLower(audienceType) {
+	case "", RoleAudienceOrganization:
+		if value != "" {
+			return nil, fmt.Errorf("the organization audience does not take a value, use --audience organization")
+		}
+		return nil, nil
+	case RoleAudienceApplication:
+		if value == "" {
+			return nil, fmt.Errorf("the application is missing, use --audience application:<app>")
+		}
+	default:
+		return nil, fmt.Errorf("invalid audience %q, use organization or application:<app>", audience)
+	}
+	application, err := ResolveApplication(ctx, cli, value)
+	if err != nil {
+		return nil, err
+	}
+	if application.AssociatedRoles == nil || !strings.EqualFold(application.AssociatedRoles.AllowedAudience, RoleAudienceApplication) {
+		return nil, fmt.Errorf("application %q uses organization roles, allow application roles in its settings to create roles for it", application.Name)
+	}
+	return &models.RoleAudience{Value: application.ID, Type: RoleAudienceApplication, Display: application.Name}, nil
+}
+
+// resolvePermissions turns permissions given as <api-identifier>:<scope> into role permissions,
+// checking that the api resource has the scope.
+func resolvePermissions(ctx context.Context, cli *CLI, permissions []string) ([]models.RolePermission, error) {
+	var result []models.RolePermission
+	resolver := newPermissionResolver(cli)
+	for _, permission := range permissions {
+		apiResource, scope, err := resolver.resolve(ctx, permission)
+		if err != nil {
+			return nil, err
+		}
+		if apiResource == nil {
+			return nil, fmt.Errorf("no api resource found for permission %q, use <api-identifier>:<scope>", permission)
+		}
+		if !hasScope(apiResource, scope) {
+			return nil, fmt.Errorf("api resource %s has no scope %q", apiResource.Identifier, scope)
+		}
+		if !slices.ContainsFunc(result, func(p models.RolePermission) bool { return p.Value == scope }) {
+			result = append(result, models.RolePermission{Value: scope})
+		}
+	}
+	return result, nil
+}
+
+// permissionResolver splits permissions given as <api-identifier>:<scope>, caching the api
+// resources it reads.
+type permissionResolver struct {
+	cli          *CLI
+	apiResources map[string][]models.APIResource
+	details      map[string]*models.APIResource
+}
+
+func newPermissionResolver(cli *CLI) *permissionResolver {
+	return &permissionResolver{cli: cli, apiResources: map[string][]models.APIResource{}, details: map[string]*models.APIResource{}}
+}
+
+// resolve returns the api resource of the permission with its scopes, and the scope after the
+// identifier. Identifiers and scopes may both contain colons, so the permission is matched against
+// the longest identifier of an api resource it starts with. The api resource is nil when no
+// identifier matches.
+func (r *permissionResolver) resolve(ctx context.Context, permission string) (*models.APIResource, string, error) {
+	var match *models.APIResource
+	for _, apiType := range []string{"BUSINESS", "SYSTEM"} {
+		if _, ok := r.apiResources[apiType]; !ok {
+			list, err := r.cli.API.APIResource.List(ctx, apiType)
+			if err != nil {
+				return nil, "", fmt.Errorf("failed to list api resources: %w", err)
+			}
+			r.apiResources[apiType] = list.APIResources
+		}
+		for i, apiResource := range r.apiResources[apiType] {
+			if strings.HasPrefix(permission, apiResource.Identifier+":") && (match == nil || len(apiResource.Identifier) > len(match.Identifier)) {
+				match = &r.apiResources[apiType][i]
+			}
+		}
+		if match != nil {
+			break
+		}
+	}
+	if match == nil {
+		return nil, "", nil
+	}
+	apiResource, ok := r.details[match.ID]
+	if !ok {
+		var err error
+		if apiResource, err = r.cli.API.APIResource.Get(ctx, match.ID); err != nil {
+			return nil, "", fmt.Errorf("failed to get api resource %s: %w", match.Identifier, err)
+		}
+		r.details[match.ID] = apiResource
+	}
+	return apiResource, strings.TrimPrefix(permission, apiResource.Identifier+":"), nil
+}
+
+// DeleteRole deletes the role and returns the deleted role.
+func DeleteRole(ctx context.Context, cli *CLI, idOrName string) (*models.RoleV2, error) {
+	role, err := ResolveRole(ctx, cli, idOrName)
+	if err != nil {
+		return nil, err
+	}
+	if err := cli.API.Role.Delete(ctx, role.ID); err != nil {
+		return nil, fmt.Errorf("failed to delete role %s: %w", role.DisplayName, err)
+	}
+	return role, nil
+}
+
+// RoleChange reports the permissions or assignments that changed, and the ones left as they were
+// because they already were, or were not, part of the role.
+type RoleChange struct {
+	Role      *models.RoleV2
+	Changed   []string
+	Unchanged []string
+}
+
+// AddRolePermissions grants the scopes, given as <api-identifier>:<scope>, to the role.
+func AddRolePermissions(ctx context.Context, cli *CLI, idOrName string, permissions []string) (*RoleChange, error) {
+	role, err := ResolveRole(ctx, cli, idOrName)
+	if err != nil {
+		return nil, err
+	}
+	resolved, err := resolvePermissions(ctx, cli, permissions)
+	if err != nil {
+		return nil, err
+	}
+	change := &RoleChange{Role: role}
+	var added []models.RolePermission
+	for _, permission := range resolved {
+		if hasRolePermission(role, permission.Value) {
+			change.Unchanged = append(change.Unchanged, permission.Value)
+			continue
+		}
+		added = append(added, permission)
+		change.Changed = append(change.Changed, permission.Value)
+	}
+	if len(added) == 0 {
+		return change, nil
+	}
+	operations := []models.PatchOperation{{Op: "add", Path: "permissions", Value: added}}
+	if _, err := cli.API.Role.Patch(ctx, role.ID, operations); err != nil {
+		return nil, fmt.Errorf("failed to add permissions to role %s: %w", role.DisplayName, err)
+	}
+	return change, nil
+}
+
+// RemoveRolePermissions revokes the scopes, given as <api-identifier>:<scope> or by name, from the role.
+func RemoveRolePermissions(ctx context.Context, cli *CLI, idOrName string, permissions []string) (*RoleChange, error) {
+	role, err := ResolveRole(ctx, cli, idOrName)
+	if err != nil {
+		return nil, err
+	}
+	change := &RoleChange{Role: role}
+	var operations []models.PatchOperation
+	resolver := newPermissionResolver(cli)
+	for _, permission := range permissions {
+		scope, ok, err := resolver.roleScope(ctx, role, permission)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			change.Unchanged = append(change.Unchanged, permission)
+			continue
+		}
+		operations = append(operations, models.PatchOperation{
+			Op:   "remove",
+			Path: fmt.Sprintf("permissions[value eq %s]", scimString(scope)),
+		})
+		change.Changed = append(change.Changed, scope)
+	}
+	if len(operations) == 0 {
+		return change, nil
+	}
+	if _, err := cli.API.Role.Patch(ctx, role.ID, operations); err != nil {
+		return nil, fmt.Errorf("failed to remove permissions from role %s: %w", role.DisplayName, err)
+	}
+	return change, nil
+}
+
+// roleScope returns the scope of the role a permission refers to. The permission is resolved as
+// <api-identifier>:<scope> first, and is otherwise the name of the scope.
+func (r *permissionResolver) roleScope(ctx context.Context, role *models.RoleV2, permission string) (string, bool, error) {
+	scope := permission
+	if strings.Contains(permission, ":") {
+		apiResource, resolved, err := r.resolve(ctx, permission)
+		if err != nil {
+			return "", false, err
+		}
+		if apiResource != nil && hasScope(apiResource, resolved) {
+			scope = resolved
+		}
+	}
+	return scope, hasRolePermission(role, scope), nil
+}
+
+func hasRolePermission(role *models.RoleV2, scope string) bool {
+	return slices.ContainsFunc(role.Permissions, func(p models.RolePermission) bool { return p.Value == scope })
+}
+
+// RoleAssignees are the users and groups, given by ID or name, that a role is assigned to.
+type RoleAssignees struct {
+	Users  []string
+	Groups []string
+}
+
+// AssignRole assigns the role to the users and groups, or unassigns it when assign is not set.
+func AssignRole(ctx context.Context, cli *CLI, idOrName string, assignees RoleAssignees, assign bool) (*RoleChange, error) {
+	if len(assignees.Users) == 0 && len(assignees.Groups) == 0 {
+		return nil, fmt.Errorf("at least one user or group is required")
+	}
+	role, err := ResolveRole(ctx, cli, idOrName)
+	if err != nil {
+		return nil, err
+	}
+	users, err := resolveUsers(ctx, cli, assignees.Users)
+	if err != nil {
+		return nil, err
+	}
+	var groups []models.Group
+	for _, idOrName := range assignees.Groups {
+		group, err := ResolveGroup(ctx, cli, idOrName)
+		if err != nil {
+			return nil, err
+		}
+		groups = append(groups, *group)
+	}
+
+	change := &RoleChange{Role: role}
+	var operations []models.PatchOperation
+	plan := func(path string, current []models.RoleMember, id, name string) {
+		if slices.ContainsFunc(current, func(m models.RoleMember) bool { return m.Value == id }) == assign {
+			change.Unchanged = append(change.Unchanged, name)
+			return
+		}
+		change.Changed = append(change.Changed, name)
+		if assign {
+			operations = append(operations, models.PatchOperation{Op: "add", Path: path, Value: []models.RoleMember{{Value: id}}})
+		} else {
+			operations = append(operations, models.PatchOperation{Op: "remove", Path: fmt.Sprintf("%s[value eq %s]", path, scimString(id))})
+		}
+	}
+	for _, user := range users {
+		plan("users", role.Users, user.ID, "user "+user.UserName)
+	}
+	for _, group := range groups {
+		plan("groups", role.Groups, group.ID, "group "+GroupName(&group))
+	}
+	if len(operations) == 0 {
+		return change, nil
+	}
+	if _, err := cli.API.Role.Patch(ctx, role.ID, operations); err != nil {
+		return nil, fmt.Errorf("failed to update the assignments of role %s: %w", role.DisplayName, err)
+	}
+	return change, nil
+}
@@ -0,0 +1,159 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/shashimalcse/asgardeo-cli/internal/api"
+	"github.com/shashimalcse/asgardeo-cli/internal/models"
+)
+
+// fakeResourceAPI serves api resources by type from memory.
+type fakeResourceAPI struct {
+	api.ResourceAPI
+	apiResources map[string][]models.APIResource
+}
+
+func (f *fakeResourceAPI) List(ctx context.Context, apiType string) (*models.APIResourceList, error) {
+	apiResources := f.apiResources[apiType]
+	return &models.APIResourceList{TotalResults: len(apiResources), APIResources: apiResources}, nil
+}
+
+func (f *fakeResourceAPI) Get(ctx context.Context, id string) (*models.APIResource, error) {
+	for _, apiResources := range f.apiResources {
+		for _, apiResource := range apiResources {
+			if apiResource.ID == id {
+				return &apiResource, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("api resource %s not found", id)
+}
+
+func testAPIResource(id, identifier string, scopes ...string) models.APIResource {
+	apiResource := models.APIResource{ID: id, Name: id, Identifier: identifier}
+	for _, scope := range scopes {
+		apiResource.Scopes = append(apiResource.Scopes, models.Scope{Name: scope})
+	}
+	return apiResource
+}
+
+// testPermissionCLI returns a CLI serving api resources whose identifiers and scopes contain colons.
+func testPermissionCLI() *CLI {
+	return &CLI{API: &api.API{APIResource: &fakeResourceAPI{apiResources: map[string][]models.APIResource{
+		"BUSINESS": {
+			testAPIResource("urn", "urn", "shop:cart:write"),
+			testAPIResource("shop", "urn:shop", "orders:read", "cart:read"),
+			testAPIResource("cart", "urn:shop:cart", "write"),
+			testAPIResource("orders", "https://api.example.com/orders", "read"),
+			testAPIResource("example", "https://api.example.com", "read"),
+		},
+		"SYSTEM": {
+			testAPIResource("users", "/o/scim2/Users", "internal_org_user_mgt_view"),
+		},
+	}}}}
+}
+
+func TestResolvePermissions(t *testing.T) {
+	cli := testPermissionCLI()
+
+	tests := []struct {
+		name        string
+		permissions []string
+		want        []string
+		wantErr     string
+	}{
+		{
+			name:        "longest identifier wins regardless of order",
+			permissions: []string{"urn:shop:cart:write"},
+			want:        []string{"write"},
+		},
+		{
+			name:        "scope containing colons",
+			permissions: []string{"urn:shop:orders:read"},
+			want:        []string{"orders:read"},
+		},
+		{
+			name:        "identifier containing a colon",
+			permissions: []string{"https://api.example.com/orders:read", "https://api.example.com:read"},
+			want:        []string{"read"},
+		},
+		{
+			name:        "system api resources",
+			permissions: []string{"/o/scim2/Users:internal_org_user_mgt_view"},
+			want:        []string{"internal_org_user_mgt_view"},
+		},
+		{
+			name:        "duplicates are dropped",
+			permissions: []string{"urn:shop:cart:write", "urn:shop:orders:read", "urn:shop:cart:write"},
+			want:        []string{"write", "orders:read"},
+		},
+		{
+			name:        "missing scope of the longest match",
+			permissions: []string{"urn:shop:cart:delete"},
+			wantErr:     `api resource urn:shop:cart has no scope "delete"`,
+		},
+		{
+			name:        "identifier without a scope",
+			permissions: []string{"https://api.example.com"},
+			wantErr:     `no api resource found for permission "https://api.example.com"`,
+		},
+		{
+			name:        "unknown identifier",
+			permissions: []string{"urn-billing:read"},
+			wantErr:     `no api resource found for permission "urn-billing:read"`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			permissions, err := resolvePermissions(context.Background(), cli, test.permissions)
+			if test.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+					t.Fatalf("resolvePermissions(%q) error = %v, want %q", test.permissions, err, test.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("resolvePermissions(%q) returned an error: %v", test.permissions, err)
+			}
+			var got []string
+			for _, permission := range permissions {
+				got = append(got, permission.Value)
+			}
+			if !slices.Equal(got, test.want) {
+				t.Errorf("resolvePermissions(%q) = %q, want %q", test.permissions, got, test.want)
+			}
+		})
+	}
+}
+
+func TestRoleScope(t *testing.T) {
+	role := &models.RoleV2{Permissions: []models.RolePermission{{Value: "read"}, {Value: "orders:read"}, {Value: "write"}}}
+	tests := []struct {
+		permission string
+		want       string
+		wantOK     bool
+	}{
+		{permission: "urn:shop:orders:read", want: "orders:read", wantOK: true},
+		{permission: "urn:shop:cart:write", want: "write", wantOK: true},
+		{permission: "orders:read", want: "orders:read", wantOK: true},
+		{permission: "read", want: "read", wantOK: true},
+		// The scope of the longest match does not exist, so the permission is not a suffix match of read.
+		{permission: "urn:shop:cart:read", want: "urn:shop:cart:read", wantOK: false},
+		{permission: "urn-billing:read", want: "urn-billing:read", wantOK: false},
+		{permission: "delete", want: "delete", wantOK: false},
+	}
+	resolver := newPermissionResolver(testPermissionCLI())
+	for _, test := range tests {
+		got, ok, err := resolver.roleScope(context.Background(), role, test.permission)
+		if err != nil {
+			t.Fatalf("roleScope(%q) returned an error: %v", test.permission, err)
+		}
+		if got != test.want || ok != test.wantOK {
+			t.Errorf("roleScope(%q) = %q, %t, want %q, %t", test.permission, got, ok, test.want, test.wantOK)
+		}
+	}
+}
@@ -0,0 +1,387 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/shashimalcse/asgardeo-cli/internal/models"
+)
+
+// GetScript returns the application with the conditional authentication script of its login flow.
+func GetScript(ctx context.Context, cli *CLI, idOrName string) (*models.Application, string, error) {
+	application, err := ResolveApplication(ctx, cli, idOrName)
+	if err != nil {
+		return nil, "", err
+	}
+	if application.AuthenticationSeq == nil {
+		return application, "", nil
+	}
+	return application, application.AuthenticationSeq.Script, nil
+}
+
+// PushScript checks the syntax of the script and replaces the conditional authentication script
+// of the login flow of the application with it. The steps of the login flow are kept.
+func PushScript(ctx context.Context, cli *CLI, idOrName, script string) (*models.Application, error) {
+	if err := CheckScriptSyntax(script); err != nil {
+		return nil, err
+	}
+	application, err := ResolveApplication(ctx, cli, idOrName)
+	if err != nil {
+		return nil, err
+	}
+	current := application.AuthenticationSeq
+	if current == nil || len(current.Steps) == 0 {
+		return nil, fmt.Errorf("application %s has no login flow, set one with 'apps login-flow set' first", application.Name)
+	}
+	sequence := *current
+	sequence.Type = "USER_DEFINED"
+	sequence.Script = script
+	if sequence.RequestPathAuthenticators == nil {
+		sequence.RequestPathAuthenticators = []interface{}{}
+	}
+	patch := map[string]interface{}{"authenticationSequence": &sequence}
+	if err := cli.API.Application.Patch(ctx, application.ID, patch); err != nil {
+		return nil, fmt.Errorf("failed to update the script of application %s: %w", application.Name, err)
+	}
+	application.AuthenticationSeq = &sequence
+	return application, nil
+}
+
+// ScriptSyntaxError reports where the syntax check of a script failed.
+type ScriptSyntaxError struct {
+	Line    int
+	Column  int
+	Message string
+}
+
+func (e *ScriptSyntaxError) Error() string {
+	if e.Line == 0 {
+		return "script syntax error: " + e.Message
+	}
+	return fmt.Sprintf("script syntax error at line %d, column %d: %s", e.Line, e.Column, e.Message)
+}
+
+var onLoginRequestPattern = regexp.MustCompile(`\bonLoginRequest\s*(=|\()`)
+
+// CheckScriptSyntax checks that the script is structurally valid JavaScript: strings, template
+// literals, regular expressions and comments are terminated, brackets are balanced, and the
+// onLoginRequest function the server calls is defined. It is not a full parser, so scripts that
+// pass may still be rejected by the server.
+func CheckScriptSyntax(script string) error {
+	if strings.TrimSpace(script) == "" {
+		return &ScriptSyntaxError{Message: "the script is empty"}
+	}
+	s := &scriptScanner{src: []rune(script), line: 1, column: 1}
+	if err := s.scan(); err != nil {
+		return err
+	}
+	if !onLoginRequestPattern.MatchString(script) {
+		return &ScriptSyntaxError{Message: "the script does not define the onLoginRequest function"}
+	}
+	return nil
+}
+
+// scriptScanner walks a script token by token, tracking the open brackets and template literals.
+type scriptScanner struct {
+	src          []rune
+	pos          int
+	line, column int
+	// open holds the open brackets. A '$' marks the substitution of a template literal, which
+	// is closed by a brace and resumes the template literal.
+	open []scriptBracket
+	// regexAllowed is set when a slash starts a regular expression rather than a division.
+	regexAllowed bool
+}
+
+type scriptBracket struct {
+	char         rune
+	line, column int
+}
+
+func (s *scriptScanner) errorf(format string, args ...interface{}) error {
+	return &ScriptSyntaxError{Line: s.line, Column: s.column, Message: fmt.Sprintf(format, args...)}
+}
+
+func (s *scriptScanner) peek(offset int) rune {
+	if s.pos+offset < len(s.src) {
+		return s.src[s.pos+offset]
+	}
+	return 0
+}
+
+func (s *scriptScanner) next() rune {
+	r := s.src[s.pos]
+	s.pos++
+	if r == '\n' {
+		s.line++
+		s.column = 1
+	} else {
+		s.column++
+	}
+	return r
+}
+
+func (s *scriptScanner) scan() error {
+	s.regexAllowed = true
+	for s.pos < len(s.src) {
+		r := s.peek(0)
+		switch {
+		case r == '/' && s.peek(1) == '/':
+			for s.pos < len(s.src) && s.peek(0) != '\n' {
+				s.next()
+			}
+		case r == '/' && s.peek(1) == '*':
+			line, column := s.line, s.column
+			s.next()
+			s.next()
+			for s.pos < len(s.src) && !(s.peek(0) == '*' && s.peek(1) == '/') {
+				s.next()
+			}
+			if s.pos >= len(s.src) {
+				return &ScriptSyntaxError{Line: line, Column: column, Message: "unterminated comment"}
+			}
+			s.next()
+			s.next()
+		case r == '/' && s.regexAllowed:
+			if err := s.scanRegex(); err != nil {
+				return err
+			}
+			s.regexAllowed = false
+		case r == '"' || r == '\'':
+			if err := s.scanString(r); err != nil {
+				return err
+			}
+			s.regexAllowed = false
+		case r == '`':
+			s.next()
+			if err := s.scanTemplate(); err != nil {
+				return err
+			}
+		case r == '(' || r == '[' || r == '{':
+			s.open = append(s.open, scriptBracket{char: r, line: s.line, column: s.column})
+			s.next()
+			s.regexAllowed = true
+		case r == ')' || r == ']' || r == '}':
+			if err := s.close(r); err != nil {
+				return err
+			}
+		case isIdentifierRune(r):
+			start := s.pos
+			for s.pos < len(s.src) && isIdentifierRune(s.peek(0)) {
+				s.next()
+			}
+			switch string(s.src[start:s.pos]) {
+			case "return", "typeof", "instanceof", "in", "of", "new", "delete", "void", "throw", "case", "do", "else", "yield":
+				s.regexAllowed = true
+			default:
+				s.regexAllowed = false
+			}
+		case r == ' ' || r == '\t' || r == '\n' || r == '\r':
+			s.next()
+		case (r == '+' || r == '-') && s.peek(1) == r:
+			// A postfix increment or decrement ends an expression, so a slash after it is a division.
+			s.next()
+			s.next()
+			s.regexAllowed = false
+		default:
+			s.next()
+			s.regexAllowed = true
+		}
+	}
+	if len(s.open) > 0 {
+		bracket := s.open[len(s.open)-1]
+		if bracket.char == '$' {
+			return &ScriptSyntaxError{Line: bracket.line, Column: bracket.column, Message: "unterminated template literal"}
+		}
+		return &ScriptSyntaxError{Line: bracket.line, Column: bracket.column, Message: fmt.Sprintf("%q is never closed", bracket.char)}
+	}
+	return nil
+}
+
+func (s *scriptScanner) close(r rune) error {
+	expected := map[rune]rune{')': '(', ']': '[', '}': '{'}[r]
+	if len(s.open) == 0 {
+		return s.errorf("unexpected %q", r)
+	}
+	bracket := s.open[len(s.open)-1]
+	if bracket.char == '$' && r == '}' {
+		s.open = s.open[:len(s.open)-1]
+		s.next()
+		return s.scanTemplate()
+	}
+	if bracket.char != expected {
+		return s.errorf("unexpected %q, %q opened at line %d, column %d is not closed", r, bracket.char, bracket.line, bracket.column)
+	}
+	s.open = s.open[:len(s.open)-1]
+	s.next()
+	// A closing parenthesis or bracket ends an expression, while a closing brace usually ends a block.
+	s.regexAllowed = r == '}'
+	return nil
+}
+
+func (s *scriptScanner) scanString(quote rune) error {
+	line, column := s.line, s.column
+	s.next()
+	for s.pos < len(s.src) {
+		switch r := s.next(); r {
+		case '\\':
+			if s.pos < len(s.src) {
+				s.next()
+			}
+		case quote:
+			return nil
+		case '\n':
+			return &ScriptSyntaxError{Line: line, Column: column, Message: "unterminated string"}
+		}
+	}
+	return &ScriptSyntaxError{Line: line, Column: column, Message: "unterminated string"}
+}
+
+// scanTemplate scans a template literal after its opening backtick, or after the closing brace of
+// one of its substitutions.
+func (s *scriptScanner) scanTemplate() error {
+	line, column := s.line, s.column
+	for s.pos < len(s.src) {
+		switch r := s.next(); {
+		case r == '\\':
+			if s.pos < len(s.src) {
+				s.next()
+			}
+		case r == '`':
+			s.regexAllowed = false
+			return nil
+		case r == '$' && s.peek(0) == '{':
+			s.open = append(s.open, scriptBracket{char: '$', line: s.line, column: s.column - 1})
+			s.next()
+			s.regexAllowed = true
+			return nil
+		}
+	}
+	return &ScriptSyntaxError{Line: line, Column: column, Message: "unterminated template literal"}
+}
+
+func (s *scriptScanner) scanRegex() error {
+	line, column := s.line, s.column
+	s.next()
+	inClass := false
+	for s.pos < len(s.src) {
+		switch r := s.next(); {
+		case r == '\\':
+			if s.pos < len(s.src) && s.peek(0) != '\n' {
+				s.next()
+			}
+		case r == '\n':
+			return &ScriptSyntaxError{Line: line, Column: column, Message: "unterminated regular expression"}
+		case r == '[':
+			inClass = true
+		case r == ']':
+			inClass = false
+		case r == '/' && !inClass:
+			for s.pos < len(s.src) && isIdentifierRune(s.peek(0)) {
+				s.next()
+			}
+			return nil
+		}
+	}
+	return &ScriptSyntaxError{Line: line, Column: column, Message: "unterminated regular expression"}
+}
+
+func isIdentifierRune(r rune) bool {
+	return r == '_' || r == '$' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r > 127
+}
+
+// UnifiedDiff returns the differences between two texts in the unified format, with three lines
+// of context around each change, or an empty string when the texts are the same.
+func UnifiedDiff(from, to, fromName, toName string) string {
+	a, b := splitLines(from), splitLines(to)
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and b[j:].
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+	type edit struct {
+		op   byte
+		line string
+		// aLine and bLine are the zero based lines of the edit in each text.
+		aLine, bLine int
+	}
+	var edits []edit
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			edits = append(edits, edit{' ', a[i], i, j})
+			i++
+			j++
+		case i < len(a) && (j == len(b) || lcs[i+1][j] >= lcs[i][j+1]):
+			edits = append(edits, edit{'-', a[i], i, j})
+			i++
+		default:
+			edits = append(edits, edit{'+', b[j], i, j})
+			j++
+		}
+	}
+
+	const context = 3
+	var sb strings.Builder
+	for start := 0; start < len(edits); {
+		if edits[start].op == ' ' {
+			start++
+			continue
+		}
+		// Grow the hunk until the next change is further than twice the context away.
+		first := max(start-context, 0)
+		end := start
+		for k := start; k < len(edits); k++ {
+			if edits[k].op != ' ' {
+				end = k
+			} else if k-end > 2*context {
+				break
+			}
+		}
+		last := min(end+context, len(edits)-1)
+		if sb.Len() == 0 {
+			fmt.Fprintf(&sb, "--- %s\n+++ %s\n", fromName, toName)
+		}
+		var aCount, bCount int
+		for _, e := range edits[first : last+1] {
+			if e.op != '+' {
+				aCount++
+			}
+			if e.op != '-' {
+				bCount++
+			}
+		}
+		fmt.Fprintf(&sb, "@@ -%s +%s @@\n", hunkRange(edits[first].aLine, aCount), hunkRange(edits[first].bLine, bCount))
+		for _, e := range edits[first : last+1] {
+			fmt.Fprintf(&sb, "%c%s\n", e.op, e.line)
+		}
+		start = last + 1
+	}
+	return sb.String()
+}
+
+func hunkRange(line, count int) string {
+	if count == 0 {
+		return fmt.Sprintf("%d,0", line)
+	}
+	return fmt.Sprintf("%d,%d", line+1, count)
+}
+
+func splitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(strings.ReplaceAll(text, "\r\n", "\n"), "\n"), "\n")
+}
@@ -0,0 +1,181 @@
+package core
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCheckScriptSyntax(t *testing.T) {
+	tests := []struct {
+		name   string
+		script string
+		// wantErr is nil when the script is valid.
+		wantErr *ScriptSyntaxError
+	}{
+		{
+			name:   "valid script",
+			script: "var onLoginRequest = function(context) {\n  executeStep(1);\n};\n",
+		},
+		{
+			name:   "division of identifiers",
+			script: "function onLoginRequest(context) {\n  var half = a / b / 2;\n}\n",
+		},
+		{
+			name:   "division after a closing parenthesis",
+			script: "function onLoginRequest(context) {\n  var half = (a + b) / 2;\n}\n",
+		},
+		{
+			name:   "division after a postfix increment",
+			script: "function onLoginRequest(context) {\n  var half = i++ / 2 + j-- / 2;\n}\n",
+		},
+		{
+			name:   "regular expression after an assignment",
+			script: "function onLoginRequest(context) {\n  var x = /re}[/]/g;\n}\n",
+		},
+		{
+			name:   "regular expression after return",
+			script: "function onLoginRequest(context) {\n  return /^admin(\\/|$)/.test(context.role);\n}\n",
+		},
+		{
+			name:   "brackets in strings and comments",
+			script: "function onLoginRequest(context) {\n  // }\n  /* ) */\n  var s = \"{\" + '(\\'';\n}\n",
+		},
+		{
+			name:   "nested template literal substitutions",
+			script: "function onLoginRequest(context) {\n  var s = `a ${ `b ${ {c: 1}.c } }` } d`;\n}\n",
+		},
+		{
+			name:    "empty script",
+			script:  " \n",
+			wantErr: &ScriptSyntaxError{Message: "the script is empty"},
+		},
+		{
+			name:    "missing onLoginRequest",
+			script:  "function onLogin(context) {}\n",
+			wantErr: &ScriptSyntaxError{Message: "the script does not define the onLoginRequest function"},
+		},
+		{
+			name:    "unterminated string",
+			script:  "function onLoginRequest(context) {\n  var a = 'abc;\n}\n",
+			wantErr: &ScriptSyntaxError{Line: 2, Column: 11, Message: "unterminated string"},
+		},
+		{
+			name:    "unterminated comment",
+			script:  "function onLoginRequest(context) {\n}\n  /* executeStep(1);\n",
+			wantErr: &ScriptSyntaxError{Line: 3, Column: 3, Message: "unterminated comment"},
+		},
+		{
+			name:    "unterminated regular expression",
+			script:  "function onLoginRequest(context) {\n  var x = /re;\n}\n",
+			wantErr: &ScriptSyntaxError{Line: 2, Column: 11, Message: "unterminated regular expression"},
+		},
+		{
+			name:    "unterminated template literal",
+			script:  "function onLoginRequest(context) {\n  var s = `a\n}\n",
+			wantErr: &ScriptSyntaxError{Line: 2, Column: 12, Message: "unterminated template literal"},
+		},
+		{
+			name:    "unterminated template substitution",
+			script:  "function onLoginRequest(context) {\n  var s = `a ${b",
+			wantErr: &ScriptSyntaxError{Line: 2, Column: 14, Message: "unterminated template literal"},
+		},
+		{
+			name:    "unclosed bracket",
+			script:  "function onLoginRequest(context) {\n  executeStep(1;\n}\n",
+			wantErr: &ScriptSyntaxError{Line: 3, Column: 1, Message: `unexpected '}', '(' opened at line 2, column 14 is not closed`},
+		},
+		{
+			name:    "bracket never closed",
+			script:  "function onLoginRequest(context) {\n  executeStep(1);\n",
+			wantErr: &ScriptSyntaxError{Line: 1, Column: 34, Message: `'{' is never closed`},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := CheckScriptSyntax(test.script)
+			if test.wantErr == nil {
+				if err != nil {
+					t.Fatalf("CheckScriptSyntax() returned an error: %v", err)
+				}
+				return
+			}
+			var syntaxErr *ScriptSyntaxError
+			if !errors.As(err, &syntaxErr) {
+				t.Fatalf("CheckScriptSyntax() error = %v, want %v", err, test.wantErr)
+			}
+			if *syntaxErr != *test.wantErr {
+				t.Errorf("CheckScriptSyntax() error = %+v, want %+v", *syntaxErr, *test.wantErr)
+			}
+		})
+	}
+}
+
+func TestUnifiedDiff(t *testing.T) {
+	tests := []struct {
+		name     string
+		from, to string
+		want     string
+	}{
+		{
+			name: "same text",
+			from: "a\nb\n",
+			to:   "a\nb\n",
+			want: "",
+		},
+		{
+			name: "pure addition to an empty text",
+			from: "",
+			to:   "a\nb\n",
+			want: "--- old\n+++ new\n@@ -0,0 +1,2 @@\n+a\n+b\n",
+		},
+		{
+			name: "pure deletion of the whole text",
+			from: "a\nb\n",
+			to:   "",
+			want: "--- old\n+++ new\n@@ -1,2 +0,0 @@\n-a\n-b\n",
+		},
+		{
+			name: "addition with context",
+			from: "1\n2\n3\n4\n5\n6\n",
+			to:   "1\n2\n3\nx\n4\n5\n6\n",
+			want: "--- old\n+++ new\n@@ -1,6 +1,7 @@\n 1\n 2\n 3\n+x\n 4\n 5\n 6\n",
+		},
+		{
+			name: "deletion with context",
+			from: "1\n2\n3\n4\n5\n6\n7\n8\n",
+			to:   "1\n2\n3\n4\n6\n7\n8\n",
+			want: "--- old\n+++ new\n@@ -2,7 +2,6 @@\n 2\n 3\n 4\n-5\n 6\n 7\n 8\n",
+		},
+		{
+			name: "change puts the deletion first",
+			from: "a\nb\nc\n",
+			to:   "a\nx\nc\n",
+			want: "--- old\n+++ new\n@@ -1,3 +1,3 @@\n a\n-b\n+x\n c\n",
+		},
+		{
+			name: "changes six lines apart share a hunk",
+			from: "a\n1\n2\n3\n4\n5\n6\nb\n",
+			to:   "A\n1\n2\n3\n4\n5\n6\nB\n",
+			want: "--- old\n+++ new\n@@ -1,8 +1,8 @@\n-a\n+A\n 1\n 2\n 3\n 4\n 5\n 6\n-b\n+B\n",
+		},
+		{
+			name: "changes more than six lines apart get their own hunks",
+			from: "a\n1\n2\n3\n4\n5\n6\n7\nb\n",
+			to:   "A\n1\n2\n3\n4\n5\n6\n7\nB\n",
+			want: "--- old\n+++ new\n@@ -1,4 +1,4 @@\n-a\n+A\n 1\n 2\n 3\n@@ -6,4 +6,4 @@\n 5\n 6\n 7\n-b\n+B\n",
+		},
+		{
+			name: "windows line endings",
+			from: "a\r\nb\r\n",
+			to:   "a\nb\n",
+			want: "",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := UnifiedDiff(test.from, test.to, "old", "new"); got != test.want {
+				t.Errorf("UnifiedDiff() =\n%s\nwant\n%s", got, test.want)
+			}
+		})
+	}
+}
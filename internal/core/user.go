@@ -0,0 +1,237 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/shashimalcse/asgardeo-cli/internal/api"
+	"github.com/shashimalcse/asgardeo-cli/internal/models"
+)
+
+// ResolveUser finds a user by its ID or by its username.
+func ResolveUser(ctx context.Context, cli *CLI, idOrUserName string) (*models.User, error) {
+	query := api.SCIMQuery{Filter: fmt.Sprintf("userName eq %s", scimString(idOrUserName))}
+	users, err := cli.API.User.Paginate(query, 2).All(ctx, 2)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search users: %w", err)
+	}
+	switch len(users) {
+	case 0:
+	case 1:
+		return &users[0], nil
+	default:
+		return nil, fmt.Errorf("more than one user is named %q, use the user ID instead", idOrUserName)
+	}
+	user, err := cli.API.User.Get(ctx, idOrUserName, nil)
+	if err != nil {
+		var apiErr *api.Error
+		if errors.As(err, &apiErr) && apiErr.Status() == http.StatusNotFound {
+			return nil, fmt.Errorf("user not found: %s", idOrUserName)
+		}
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	return user, nil
+}
+
+// scimString quotes a value for use in a SCIM filter.
+func scimString(value string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(value) + `"`
+}
+
+// UserCreateInputs holds the values of a new user.
+type UserCreateInputs struct {
+	UserName       string   `json:"userName"`
+	Password       string   `json:"password,omitempty"`
+	AskPassword    bool     `json:"askPassword,omitempty"`
+	GivenName      string   `json:"givenName,omitempty"`
+	FamilyName     string   `json:"familyName,omitempty"`
+	Emails         []string `json:"emails,omitempty"`
+	PhoneNumbers   []string `json:"phoneNumbers,omitempty"`
+	Department     string   `json:"department,omitempty"`
+	Organization   string   `json:"organization,omitempty"`
+	EmployeeNumber string   `json:"employeeNumber,omitempty"`
+}
+
+// Validate checks that the user can be created with the inputs.
+func (i *UserCreateInputs) Validate() error {
+	if strings.TrimSpace(i.UserName) == "" {
+		return fmt.Errorf("username is required")
+	}
+	switch {
+	case i.AskPassword && i.Password != "":
+		return fmt.Errorf("a password cannot be given when the user is asked to set one")
+	case i.AskPassword && len(i.Emails) == 0:
+		return fmt.Errorf("an email is required to ask the user to set a password")
+	case !i.AskPassword && i.Password == "":
+		return fmt.Errorf("a password is required, or ask the user to set one")
+	}
+	return nil
+}
+
+// BuildUser builds the SCIM user of the inputs.
+func BuildUser(inputs UserCreateInputs) (*models.User, error) {
+	if err := inputs.Validate(); err != nil {
+		return nil, err
+	}
+	user := &models.User{
+		Schemas:  []string{models.SCIMUserSchema},
+		UserName: inputs.UserName,
+		Password: inputs.Password,
+	}
+	if inputs.GivenName != "" || inputs.FamilyName != "" {
+		user.Name = &models.UserName{GivenName: inputs.GivenName, FamilyName: inputs.FamilyName}
+	}
+	user.Emails = multiValues(inputs.Emails)
+	user.PhoneNumbers = multiValues(inputs.PhoneNumbers)
+	if inputs.Department != "" || inputs.Organization != "" || inputs.EmployeeNumber != "" {
+		user.Schemas = append(user.Schemas, models.SCIMEnterpriseUserSchema)
+		user.Enterprise = &models.EnterpriseUser{
+			Department:     inputs.Department,
+			Organization:   inputs.Organization,
+			EmployeeNumber: inputs.EmployeeNumber,
+		}
+	}
+	if inputs.AskPassword {
+		askPassword := models.SCIMBool(true)
+		user.WSO2 = &models.WSO2UserSchema{AskPassword: &askPassword}
+	}
+	return user, nil
+}
+
+// CreateUser creates a user and returns the created user.
+func CreateUser(ctx context.Context, cli *CLI, inputs UserCreateInputs) (*models.User, error) {
+	user, err := BuildUser(inputs)
+	if err != nil {
+		return nil, err
+	}
+	created, err := cli.API.User.Create(ctx, user)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create user %s: %w", inputs.UserName, err)
+	}
+	return created, nil
+}
+
+// multiValues turns the values into a multi-valued attribute, marking the first one as primary.
+func multiValues(values []string) []models.MultiValue {
+	var result []models.MultiValue
+	for i, value := range values {
+		result = append(result, models.MultiValue{Value: value, Primary: i == 0})
+	}
+	return result
+}
+
+// UserUpdateInputs holds the changes to apply to a user. Nil and empty fields are left unchanged.
+type UserUpdateInputs struct {
+	GivenName      *string
+	FamilyName     *string
+	DisplayName    *string
+	Emails         []string
+	PhoneNumbers   []string
+	Department     *string
+	Organization   *string
+	EmployeeNumber *string
+}
+
+// operations returns the SCIM patch operations of the changes.
+func (i *UserUpdateInputs) operations() []models.PatchOperation {
+	value := map[string]interface{}{}
+	name := map[string]interface{}{}
+	if i.GivenName != nil {
+		name["givenName"] = *i.GivenName
+	}
+	if i.FamilyName != nil {
+		name["familyName"] = *i.FamilyName
+	}
+	if len(name) > 0 {
+		value["name"] = name
+	}
+	if i.DisplayName != nil {
+		value["displayName"] = *i.DisplayName
+	}
+	if len(i.Emails) > 0 {
+		value["emails"] = multiValues(i.Emails)
+	}
+	if len(i.PhoneNumbers) > 0 {
+		value["phoneNumbers"] = multiValues(i.PhoneNumbers)
+	}
+	enterprise := map[string]interface{}{}
+	if i.Department != nil {
+		enterprise["department"] = *i.Department
+	}
+	if i.Organization != nil {
+		enterprise["organization"] = *i.Organization
+	}
+	if i.EmployeeNumber != nil {
+		enterprise["employeeNumber"] = *i.EmployeeNumber
+	}
+	if len(enterprise) > 0 {
+		value[models.SCIMEnterpriseUserSchema] = enterprise
+	}
+	if len(value) == 0 {
+		return nil
+	}
+	return []models.PatchOperation{{Op: "replace", Value: value}}
+}
+
+// UpdateUser applies the changes to the user and returns the updated user.
+func UpdateUser(ctx context.Context, cli *CLI, idOrUserName string, inputs UserUpdateInputs) (*models.User, error) {
+	operations := inputs.operations()
+	if len(operations) == 0 {
+		return nil, fmt.Errorf("no changes were given")
+	}
+	return patchUser(ctx, cli, idOrUserName, operations)
+}
+
+// ResetUserPassword sets a new password for the user.
+func ResetUserPassword(ctx context.Context, cli *CLI, idOrUserName, password string) (*models.User, error) {
+	if password == "" {
+		return nil, fmt.Errorf("password is required")
+	}
+	return patchUser(ctx, cli, idOrUserName, []models.PatchOperation{
+		{Op: "replace", Value: map[string]interface{}{"password": password}},
+	})
+}
+
+// ForceUserPasswordReset makes the user reset the password, which emails the user a reset link.
+func ForceUserPasswordReset(ctx context.Context, cli *CLI, idOrUserName string) (*models.User, error) {
+	return patchUser(ctx, cli, idOrUserName, []models.PatchOperation{
+		{Op: "replace", Value: map[string]interface{}{models.SCIMWSO2UserSchema: map[string]interface{}{"forcePasswordReset": true}}},
+	})
+}
+
+// SetUserLocked locks or unlocks the account of the user.
+func SetUserLocked(ctx context.Context, cli *CLI, idOrUserName string, locked bool) (*models.User, error) {
+	return patchUser(ctx, cli, idOrUserName, []models.PatchOperation{
+		{Op: "replace", Value: map[string]interface{}{models.SCIMWSO2UserSchema: map[string]interface{}{"accountLocked": locked}}},
+	})
+}
+
+func patchUser(ctx context.Context, cli *CLI, idOrUserName string, operations []models.PatchOperation) (*models.User, error) {
+	user, err := ResolveUser(ctx, cli, idOrUserName)
+	if err != nil {
+		return nil, err
+	}
+	updated, err := cli.API.User.Patch(ctx, user.ID, operations)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update user %s: %w", user.UserName, err)
+	}
+	if updated == nil {
+		return user, nil
+	}
+	return updated, nil
+}
+
+// DeleteUser deletes the user and returns the deleted user.
+func DeleteUser(ctx context.Context, cli *CLI, idOrUserName string) (*models.User, error) {
+	user, err := ResolveUser(ctx, cli, idOrUserName)
+	if err != nil {
+		return nil, err
+	}
+	if err := cli.API.User.Delete(ctx, user.ID); err != nil {
+		return nil, fmt.Errorf("failed to delete user %s: %w", user.UserName, err)
+	}
+	return user, nil
+}
@@ -0,0 +1,83 @@
+package core
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/shashimalcse/asgardeo-cli/internal/api"
+	"github.com/shashimalcse/asgardeo-cli/internal/models"
+)
+
+type UserExportFormat string
+
+const (
+	UserExportCSV   UserExportFormat = "csv"
+	UserExportJSONL UserExportFormat = "jsonl"
+)
+
+// ExportUsers writes the users matching the filter, fetching them page by page. CSV files have
+// the columns read by ReadUserImport, so they can be imported into another tenant. JSON lines
+// files hold one SCIM user per line, with every attribute returned by the server.
+func ExportUsers(ctx context.Context, cli *CLI, w io.Writer, format UserExportFormat, filter string, pageSize int) (int, error) {
+	var write func(user *models.User) error
+	var flush func() error
+	switch format {
+	case UserExportCSV:
+		writer := csv.NewWriter(w)
+		header := []string{"id"}
+		for _, attribute := range userAttributes {
+			if attribute.get != nil {
+				header = append(header, attribute.name)
+			}
+		}
+		if err := writer.Write(header); err != nil {
+			return 0, fmt.Errorf("failed to write the users: %w", err)
+		}
+		write = func(user *models.User) error {
+			return writer.Write(userExportRow(user))
+		}
+		flush = func() error {
+			writer.Flush()
+			return writer.Error()
+		}
+	case UserExportJSONL:
+		encoder := json.NewEncoder(w)
+		encoder.SetEscapeHTML(false)
+		write = func(user *models.User) error { return encoder.Encode(user) }
+		flush = func() error { return nil }
+	default:
+		return 0, fmt.Errorf("unsupported export format %q, use csv or jsonl", format)
+	}
+
+	paginator := cli.API.User.Paginate(api.SCIMQuery{Filter: filter}, pageSize)
+	exported := 0
+	for paginator.HasNext() {
+		users, err := paginator.Next(ctx)
+		if err != nil {
+			return exported, fmt.Errorf("failed to list users: %w", err)
+		}
+		for i := range users {
+			if err := write(&users[i]); err != nil {
+				return exported, fmt.Errorf("failed to write the users: %w", err)
+			}
+			exported++
+		}
+	}
+	if err := flush(); err != nil {
+		return exported, fmt.Errorf("failed to write the users: %w", err)
+	}
+	return exported, nil
+}
+
+func userExportRow(user *models.User) []string {
+	row := []string{user.ID}
+	for _, attribute := range userAttributes {
+		if attribute.get != nil {
+			row = append(row, attribute.get(user))
+		}
+	}
+	return row
+}
@@ -0,0 +1,612 @@
+package core
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/shashimalcse/asgardeo-cli/internal/api"
+	"github.com/shashimalcse/asgardeo-cli/internal/models"
+)
+
+const (
+	DefaultImportBatchSize   = 100
+	DefaultImportConcurrency = 4
+
+	// multiValueSeparator separates the values of a multi-valued attribute in a CSV cell.
+	multiValueSeparator = ";"
+)
+
+// userAttribute is a user attribute that can be imported from and exported to a CSV column.
+type userAttribute struct {
+	name    string
+	aliases []string
+	set     func(inputs *UserCreateInputs, value string) error
+	get     func(user *models.User) string
+}
+
+var userAttributes = []userAttribute{
+	{
+		name:    "userName",
+		aliases: []string{"username", "user"},
+		set:     func(i *UserCreateInputs, v string) error { i.UserName = v; return nil },
+		get:     func(u *models.User) string { return u.UserName },
+	},
+	{
+		name: "password",
+		set:  func(i *UserCreateInputs, v string) error { i.Password = v; return nil },
+	},
+	{
+		name: "askPassword",
+		set: func(i *UserCreateInputs, v string) error {
+			askPassword, err := strconv.ParseBool(v)
+			if err != nil {
+				return fmt.Errorf("askPassword must be true or false, got %q", v)
+			}
+			i.AskPassword = askPassword
+			return nil
+		},
+	},
+	{
+		name:    "name.givenName",
+		aliases: []string{"givenName", "firstName"},
+		set:     func(i *UserCreateInputs, v string) error { i.GivenName = v; return nil },
+		get: func(u *models.User) string {
+			if u.Name == nil {
+				return ""
+			}
+			return u.Name.GivenName
+		},
+	},
+	{
+		name:    "name.familyName",
+		aliases: []string{"familyName", "lastName", "surname"},
+		set:     func(i *UserCreateInputs, v string) error { i.FamilyName = v; return nil },
+		get: func(u *models.User) string {
+			if u.Name == nil {
+				return ""
+			}
+			return u.Name.FamilyName
+		},
+	},
+	{
+		name:    "emails",
+		aliases: []string{"email"},
+		set:     func(i *UserCreateInputs, v string) error { i.Emails = splitMultiValue(v); return nil },
+		get:     func(u *models.User) string { return joinMultiValues(u.Emails) },
+	},
+	{
+		name:    "phoneNumbers",
+		aliases: []string{"phone", "phoneNumber", "mobile"},
+		set:     func(i *UserCreateInputs, v string) error { i.PhoneNumbers = splitMultiValue(v); return nil },
+		get:     func(u *models.User) string { return joinMultiValues(u.PhoneNumbers) },
+	},
+	{
+		name: "department",
+		set:  func(i *UserCreateInputs, v string) error { i.Department = v; return nil },
+		get: func(u *models.User) string {
+			return enterpriseAttribute(u, func(e *models.EnterpriseUser) string { return e.Department })
+		},
+	},
+	{
+		name: "organization",
+		set:  func(i *UserCreateInputs, v string) error { i.Organization = v; return nil },
+		get: func(u *models.User) string {
+			return enterpriseAttribute(u, func(e *models.EnterpriseUser) string { return e.Organization })
+		},
+	},
+	{
+		name: "employeeNumber",
+		set:  func(i *UserCreateInputs, v string) error { i.EmployeeNumber = v; return nil },
+		get: func(u *models.User) string {
+			return enterpriseAttribute(u, func(e *models.EnterpriseUser) string { return e.EmployeeNumber })
+		},
+	},
+}
+
+// UserImportAttributes returns the names of the attributes that CSV columns can be mapped to.
+func UserImportAttributes() []string {
+	var names []string
+	for _, attribute := range userAttributes {
+		names = append(names, attribute.name)
+	}
+	return names
+}
+
+// findUserAttribute finds the attribute with the name or alias, ignoring case and punctuation.
+func findUserAttribute(name string) *userAttribute {
+	key := attributeKey(name)
+	for i, attribute := range userAttributes {
+		if attributeKey(attribute.name) == key {
+			return &userAttributes[i]
+		}
+		for _, alias := range attribute.aliases {
+			if attributeKey(alias) == key {
+				return &userAttributes[i]
+			}
+		}
+	}
+	return nil
+}
+
+func attributeKey(name string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		return -1
+	}, name)
+}
+
+func splitMultiValue(value string) []string {
+	var values []string
+	for _, part := range strings.Split(value, multiValueSeparator) {
+		if part = strings.TrimSpace(part); part != "" {
+			values = append(values, part)
+		}
+	}
+	return values
+}
+
+// joinMultiValues joins the values into a CSV cell, starting with the primary value.
+func joinMultiValues(values []models.MultiValue) string {
+	sorted := slices.Clone(values)
+	slices.SortStableFunc(sorted, func(a, b models.MultiValue) int {
+		switch {
+		case a.Primary == b.Primary:
+			return 0
+		case a.Primary:
+			return -1
+		}
+		return 1
+	})
+	var parts []string
+	for _, value := range sorted {
+		parts = append(parts, value.Value)
+	}
+	return strings.Join(parts, multiValueSeparator)
+}
+
+func enterpriseAttribute(user *models.User, get func(*models.EnterpriseUser) string) string {
+	if user.Enterprise == nil {
+		return ""
+	}
+	return get(user.Enterprise)
+}
+
+// UserImportRow is a row of an import file, holding either the user to create or the reason the
+// row cannot be imported.
+type UserImportRow struct {
+	Line     int
+	UserName string
+	User     *models.User
+	Err      error
+}
+
+// UserImport holds the rows read from an import file.
+type UserImport struct {
+	Rows []UserImportRow
+	// Ignored holds the columns that are not mapped to any attribute.
+	Ignored []string
+}
+
+// ReadUserImport reads users from a CSV file with a header row. Columns are mapped to the
+// attributes with the same name or alias, unless the mapping maps them to another attribute.
+// Users without a password are asked to set one when askPassword is set.
+func ReadUserImport(r io.Reader, mapping map[string]string, askPassword bool) (*UserImport, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("the file is empty, a header row is required")
+		}
+		return nil, fmt.Errorf("failed to read the header row: %w", err)
+	}
+	if len(header) > 0 {
+		header[0] = strings.TrimPrefix(header[0], "\ufeff")
+	}
+	columns, ignored, err := mapUserImportColumns(header, mapping)
+	if err != nil {
+		return nil, err
+	}
+
+	userImport := &UserImport{Ignored: ignored}
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		var parseErr *csv.ParseError
+		if err != nil && !errors.As(err, &parseErr) {
+			return nil, fmt.Errorf("failed to read the file: %w", err)
+		}
+		if parseErr != nil {
+			userImport.Rows = append(userImport.Rows, UserImportRow{Line: parseErr.StartLine, Err: parseErr.Err})
+			continue
+		}
+		line, _ := reader.FieldPos(0)
+		userImport.Rows = append(userImport.Rows, userImportRow(line, record, columns, askPassword))
+	}
+	return userImport, nil
+}
+
+// mapUserImportColumns returns the attribute of each column, nil for the ignored columns.
+func mapUserImportColumns(header []string, mapping map[string]string) ([]*userAttribute, []string, error) {
+	for column := range mapping {
+		if !slices.Contains(header, column) {
+			return nil, nil, fmt.Errorf("column %q is mapped but not found in the header row", column)
+		}
+	}
+	columns := make([]*userAttribute, len(header))
+	var ignored []string
+	mapped := map[string]string{}
+	for i, column := range header {
+		name, ok := mapping[column]
+		if !ok {
+			name = column
+		}
+		attribute := findUserAttribute(name)
+		switch {
+		case attribute == nil && ok:
+			return nil, nil, fmt.Errorf("column %q is mapped to an unknown attribute %q, the attributes are %s",
+				column, name, strings.Join(UserImportAttributes(), ", "))
+		case attribute == nil:
+			// IDs are generated by the server, so the column of exported users is left out quietly.
+			if attributeKey(column) != "id" {
+				ignored = append(ignored, column)
+			}
+			continue
+		}
+		if other, ok := mapped[attribute.name]; ok {
+			return nil, nil, fmt.Errorf("columns %q and %q are both mapped to %s", other, column, attribute.name)
+		}
+		mapped[attribute.name] = column
+		columns[i] = attribute
+	}
+	if _, ok := mapped["userName"]; !ok {
+		return nil, nil, fmt.Errorf("no column is mapped to userName, use --map <column>=userName")
+	}
+	return columns, ignored, nil
+}
+
+func userImportRow(line int, record []string, columns []*userAttribute, askPassword bool) UserImportRow {
+	row := UserImportRow{Line: line}
+	var inputs UserCreateInputs
+	for i, value := range record {
+		value = strings.TrimSpace(value)
+		if columns[i] == nil || value == "" {
+			continue
+		}
+		if err := columns[i].set(&inputs, value); err != nil {
+			row.Err = err
+			return row
+		}
+	}
+	row.UserName = inputs.UserName
+	if askPassword && inputs.Password == "" {
+		inputs.AskPassword = true
+	}
+	row.User, row.Err = BuildUser(inputs)
+	return row
+}
+
+// UserImportOptions configures how the users are sent to the server.
+type UserImportOptions struct {
+	// BatchSize is the number of users created by each bulk request.
+	BatchSize int
+	// Concurrency is the number of bulk requests sent at the same time.
+	Concurrency int
+	// Checkpoint is the file recording the imported users, so that an interrupted or partly
+	// failed import can be resumed without creating the imported users again.
+	Checkpoint string
+	Resume     bool
+	// Progress is called after every batch.
+	Progress func(progress UserImportProgress)
+}
+
+type UserImportProgress struct {
+	Total    int
+	Imported int
+	Skipped  int
+	Failed   int
+}
+
+// UserImportFailure is a row that could not be imported.
+type UserImportFailure struct {
+	Line     int
+	UserName string
+	Status   int
+	Err      string
+}
+
+type UserImportResult struct {
+	UserImportProgress
+	Failures []UserImportFailure
+}
+
+// UserImportCheckpoint returns the default checkpoint file of an import file.
+func UserImportCheckpoint(file string) string {
+	return file + ".checkpoint"
+}
+
+// userImportCheckpoint records the users imported from a file.
+type userImportCheckpoint struct {
+	File     string   `json:"file"`
+	Imported []string `json:"imported"`
+}
+
+type userImportBatch struct {
+	rows     []UserImportRow
+	response *models.BulkResponse
+	err      error
+}
+
+// ImportUsers creates the users of the rows with SCIM bulk requests, sent concurrently in batches.
+// The imported users are recorded in the checkpoint file after every batch. The checkpoint is
+// removed once every row is imported, and kept otherwise so the import can be resumed.
+func ImportUsers(ctx context.Context, cli *CLI, file string, rows []UserImportRow, options UserImportOptions) (*UserImportResult, error) {
+	if options.BatchSize <= 0 {
+		options.BatchSize = DefaultImportBatchSize
+	}
+	if options.Concurrency <= 0 {
+		options.Concurrency = DefaultImportConcurrency
+	}
+	if options.Checkpoint == "" {
+		options.Checkpoint = UserImportCheckpoint(file)
+	}
+	checkpoint, err := loadUserImportCheckpoint(options.Checkpoint, file, options.Resume)
+	if err != nil {
+		return nil, err
+	}
+	imported := map[string]bool{}
+	for _, userName := range checkpoint.Imported {
+		imported[userName] = true
+	}
+
+	result := &UserImportResult{UserImportProgress: UserImportProgress{Total: len(rows)}}
+	var pending []UserImportRow
+	for _, row := range rows {
+		switch {
+		case row.Err != nil:
+			result.fail(row, 0, row.Err.Error())
+		case imported[row.UserName]:
+			result.Skipped++
+		default:
+			pending = append(pending, row)
+		}
+	}
+	progress := func() {
+		if options.Progress != nil {
+			options.Progress(result.UserImportProgress)
+		}
+	}
+	progress()
+
+	batchCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	batches := make(chan []UserImportRow)
+	results := make(chan userImportBatch)
+	var workers sync.WaitGroup
+	for i := 0; i < options.Concurrency; i++ {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			for rows := range batches {
+				operations := make([]models.BulkOperation, len(rows))
+				for i, row := range rows {
+					operations[i] = models.BulkOperation{Method: http.MethodPost, BulkID: bulkID(row), Path: "/Users", Data: row.User}
+				}
+				response, err := cli.API.Bulk.Send(batchCtx, operations)
+				results <- userImportBatch{rows: rows, response: response, err: err}
+			}
+		}()
+	}
+	go func() {
+		defer close(batches)
+		for start := 0; start < len(pending); start += options.BatchSize {
+			select {
+			case batches <- pending[start:min(start+options.BatchSize, len(pending))]:
+			case <-batchCtx.Done():
+				return
+			}
+		}
+	}()
+	go func() {
+		workers.Wait()
+		close(results)
+	}()
+
+	// The results are drained until every worker is done, even once the import stops, so that no
+	// worker is left blocked on sending its batch.
+	var stopErr, saveErr error
+	for batch := range results {
+		switch {
+		case batch.err == nil:
+			result.record(batch.rows, batch.response, checkpoint)
+		case errors.Is(batch.err, api.ErrCircuitOpen) || batchCtx.Err() != nil:
+			// The batch was not sent or not completed, so it is left to a resumed import
+			// rather than reported as failed.
+			if stopErr == nil {
+				stopErr = batch.err
+			}
+			cancel()
+			continue
+		default:
+			result.recheck(ctx, cli, batch.rows, batch.err, checkpoint)
+		}
+		if saveErr != nil {
+			continue
+		}
+		if err := checkpoint.save(options.Checkpoint); err != nil {
+			saveErr = err
+			cancel()
+			continue
+		}
+		progress()
+	}
+	if saveErr != nil {
+		return result, saveErr
+	}
+	if err := ctx.Err(); err != nil {
+		return result, fmt.Errorf("import interrupted: %w", err)
+	}
+	if stopErr != nil {
+		return result, fmt.Errorf("import stopped, resume it once the server is available: %w", stopErr)
+	}
+	if len(result.Failures) == 0 {
+		if err := os.Remove(options.Checkpoint); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return result, fmt.Errorf("failed to remove the checkpoint: %w", err)
+		}
+	}
+	return result, nil
+}
+
+func bulkID(row UserImportRow) string {
+	return "line-" + strconv.Itoa(row.Line)
+}
+
+// record records the outcome of each operation of a bulk response.
+func (r *UserImportResult) record(rows []UserImportRow, response *models.BulkResponse, checkpoint *userImportCheckpoint) {
+	responses := map[string]models.BulkOperationResponse{}
+	if response != nil {
+		for _, operation := range response.Operations {
+			responses[operation.BulkID] = operation
+		}
+	}
+	for _, row := range rows {
+		operation, ok := responses[bulkID(row)]
+		switch {
+		case !ok:
+			r.fail(row, 0, "the server did not return the result of the user")
+		case operation.Status >= http.StatusBadRequest:
+			r.fail(row, int(operation.Status), bulkOperationError(operation))
+		default:
+			r.Imported++
+			checkpoint.Imported = append(checkpoint.Imported, row.UserName)
+		}
+	}
+}
+
+// recheck records the rows of a batch whose bulk request failed. A request that failed without a
+// response or with a server error may still have been applied, for instance when the response timed
+// out after the users were created, so the users that exist now are recorded as imported rather
+// than failed, which would make a resumed import create them again.
+func (r *UserImportResult) recheck(ctx context.Context, cli *CLI, rows []UserImportRow, err error, checkpoint *userImportCheckpoint) {
+	status := errorStatus(err)
+	for _, row := range rows {
+		if status == 0 || status >= http.StatusInternalServerError {
+			query := api.SCIMQuery{Filter: fmt.Sprintf("userName eq %s", scimString(row.UserName))}
+			users, lookupErr := cli.API.User.Paginate(query, 1).All(ctx, 1)
+			if lookupErr == nil && len(users) == 1 {
+				r.Imported++
+				checkpoint.Imported = append(checkpoint.Imported, row.UserName)
+				continue
+			}
+		}
+		r.fail(row, status, err.Error())
+	}
+}
+
+func (r *UserImportResult) fail(row UserImportRow, status int, message string) {
+	r.Failed++
+	r.Failures = append(r.Failures, UserImportFailure{Line: row.Line, UserName: row.UserName, Status: status, Err: message})
+}
+
+// bulkOperationError returns the message of a failed bulk operation, taken from its SCIM error.
+func bulkOperationError(operation models.BulkOperationResponse) string {
+	var scimError struct {
+		Detail string `json:"detail"`
+	}
+	_ = json.Unmarshal(operation.Response, &scimError)
+	message := fmt.Sprintf("%d %s", operation.Status, http.StatusText(int(operation.Status)))
+	if scimError.Detail != "" {
+		message += ": " + scimError.Detail
+	}
+	return message
+}
+
+func errorStatus(err error) int {
+	var apiErr *api.Error
+	if errors.As(err, &apiErr) {
+		return apiErr.Status()
+	}
+	return 0
+}
+
+// loadUserImportCheckpoint loads the checkpoint of a resumed import, or starts a new one.
+func loadUserImportCheckpoint(path, file string, resume bool) (*userImportCheckpoint, error) {
+	source, err := filepath.Abs(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve the path of %s: %w", file, err)
+	}
+	data, err := os.ReadFile(path)
+	switch {
+	case errors.Is(err, os.ErrNotExist) && resume:
+		return nil, fmt.Errorf("no checkpoint found at %s to resume from", path)
+	case errors.Is(err, os.ErrNotExist):
+		return &userImportCheckpoint{File: source}, nil
+	case err != nil:
+		return nil, fmt.Errorf("failed to read the checkpoint: %w", err)
+	case !resume:
+		return nil, fmt.Errorf("a checkpoint of a previous import exists at %s, use --resume to continue it or remove it to start over", path)
+	}
+	checkpoint := &userImportCheckpoint{}
+	if err := json.Unmarshal(data, checkpoint); err != nil {
+		return nil, fmt.Errorf("failed to parse the checkpoint %s: %w", path, err)
+	}
+	if checkpoint.File != source {
+		return nil, fmt.Errorf("the checkpoint %s belongs to the import of %s", path, checkpoint.File)
+	}
+	return checkpoint, nil
+}
+
+// save writes the checkpoint to a temporary file first, so an interrupted write keeps the previous one.
+func (c *userImportCheckpoint) save(path string) error {
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode the checkpoint: %w", err)
+	}
+	temp := path + ".tmp"
+	if err := os.WriteFile(temp, data, 0600); err != nil {
+		return fmt.Errorf("failed to write the checkpoint: %w", err)
+	}
+	if err := os.Rename(temp, path); err != nil {
+		return fmt.Errorf("failed to write the checkpoint: %w", err)
+	}
+	return nil
+}
+
+// WriteUserImportErrors writes the failed rows to a CSV report.
+func WriteUserImportErrors(path string, failures []UserImportFailure) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create the error report: %w", err)
+	}
+	defer f.Close()
+	w := csv.NewWriter(f)
+	_ = w.Write([]string{"line", "userName", "status", "error"})
+	for _, failure := range failures {
+		status := ""
+		if failure.Status != 0 {
+			status = strconv.Itoa(failure.Status)
+		}
+		_ = w.Write([]string{strconv.Itoa(failure.Line), failure.UserName, status, failure.Err})
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return fmt.Errorf("failed to write the error report: %w", err)
+	}
+	return f.Close()
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/charmbracelet/bubbles/spinner"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/shashimalcse/asgardeo-cli/internal/api"
 	"github.com/shashimalcse/asgardeo-cli/internal/core"
 	"github.com/shashimalcse/asgardeo-cli/internal/models"
 	"github.com/shashimalcse/asgardeo-cli/internal/tui"
@@ -35,15 +36,39 @@ type ApiResourceListModel struct {
 	scopeList           list.Model
 	apiResourceList     []models.APIResource
 	selectedApiResource models.APIResource
+	paginator           *api.Paginator[models.APIResource]
+	limit               int
+	total               int
+	hasNext             bool
+	loading             bool
 }
 
-func NewApiResourceListModel(cli *core.CLI) *ApiResourceListModel {
+// apiResourcePage is a page of api resources fetched by the paginator, with the state of the
+// paginator after the fetch. The paginator is only read by the fetching command, so the model
+// keeps its own copy of that state.
+type apiResourcePage struct {
+	apiResources []models.APIResource
+	total        int
+	hasNext      bool
+}
+
+// fetchMoreThreshold is how close to the end of the list the cursor gets before the next page is fetched.
+const fetchMoreThreshold = 5
+
+// NewApiResourceListModel returns a model listing the api resources of the paginator, fetching the
+// next page as the cursor reaches the end of the list. A limit of zero lists every api resource.
+func NewApiResourceListModel(cli *core.CLI, paginator *api.Paginator[models.APIResource], limit int) *ApiResourceListModel {
 
 	return &ApiResourceListModel{
-		styles:  tui.DefaultStyles(),
-		spinner: newSpinner(),
-		cli:     cli,
-		state:   StateFetching,
+		styles:    tui.DefaultStyles(),
+		spinner:   newSpinner(),
+		cli:       cli,
+		state:     StateFetching,
+		paginator: paginator,
+		limit:     limit,
+		total:     -1,
+		hasNext:   true,
+		loading:   true,
 	}
 
 }
@@ -59,11 +84,35 @@ func (m *ApiResourceListModel) fetchApiResources() tea.Msg {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
-	apiList, err := m.cli.API.APIResource.List(ctx, "BUSINESS")
+	apiResources, err := m.paginator.Next(ctx)
 	if err != nil {
 		return err
 	}
-	return apiList
+	return apiResourcePage{apiResources: apiResources, total: m.paginator.TotalResults(), hasNext: m.paginator.HasNext()}
+}
+
+// hasMore reports whether more api resources can be fetched within the limit.
+func (m *ApiResourceListModel) hasMore() bool {
+	return m.hasNext && (m.limit <= 0 || len(m.apiResourceList) < m.limit)
+}
+
+// fetchMore fetches the next page when the cursor is close to the end of the list.
+func (m *ApiResourceListModel) fetchMore() tea.Cmd {
+	if m.loading || !m.hasMore() || m.list.FilterState() != list.Unfiltered {
+		return nil
+	}
+	if m.list.Index() < len(m.list.Items())-fetchMoreThreshold {
+		return nil
+	}
+	m.loading = true
+	return m.fetchApiResources
+}
+
+func (m *ApiResourceListModel) title() string {
+	if m.hasMore() && m.total > len(m.apiResourceList) {
+		return fmt.Sprintf("Api Resources (%d of %d)", len(m.apiResourceList), m.total)
+	}
+	return "Api Resources"
 }
 
 func (m *ApiResourceListModel) fetchApiResource() error {
@@ -116,18 +165,29 @@ func (m *ApiResourceListModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.scopeList.SetSize(m.width-h, m.height-v)
 			m.state = StateApiResourceSelected
 		}
-	case *models.APIResourceList:
-		m.apiResourceList = msg.APIResources
+	case apiResourcePage:
+		m.loading = false
+		m.total = msg.total
+		m.hasNext = msg.hasNext
+		page := msg.apiResources
+		if m.limit > 0 && len(m.apiResourceList)+len(page) > m.limit {
+			page = page[:m.limit-len(m.apiResourceList)]
+		}
+		m.apiResourceList = append(m.apiResourceList, page...)
 		var ApiResources []list.Item
-		for _, app := range msg.APIResources {
+		for _, app := range page {
 			ApiResources = append(ApiResources, tui.NewItem(app.Name, app.ID))
 		}
-		m.list = list.New(ApiResources, list.NewDefaultDelegate(), 0, 0)
-		m.list.Title = "Api Resources"
-		h, v := m.styles.List.GetFrameSize()
-		m.list.SetSize(m.width-h, m.height-v)
-		m.state = StateCompleted
-		return m, nil
+		if m.state == StateFetching {
+			m.list = list.New(ApiResources, list.NewDefaultDelegate(), 0, 0)
+			m.list.Title = m.title()
+			h, v := m.styles.List.GetFrameSize()
+			m.list.SetSize(m.width-h, m.height-v)
+			m.state = StateCompleted
+			return m, m.fetchMore()
+		}
+		cmd := m.list.SetItems(append(m.list.Items(), ApiResources...))
+		return m, tea.Batch(cmd, m.fetchMore())
 	case error:
 		m.state = StateError
 		m.stateError = msg
@@ -138,14 +198,16 @@ func (m *ApiResourceListModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	}
 
 	var cmd tea.Cmd
+	var fetchCmd tea.Cmd
 	if m.state == StateCompleted {
 		m.list, _ = m.list.Update(msg)
+		fetchCmd = m.fetchMore()
 	}
 	if m.state == StateApiResourceSelected {
 		m.scopeList, _ = m.scopeList.Update(msg)
 	}
 	m.spinner, cmd = m.spinner.Update(msg)
-	return m, cmd
+	return m, tea.Batch(cmd, fetchCmd)
 }
 
 func (m *ApiResourceListModel) View() string {
@@ -153,7 +215,7 @@ func (m *ApiResourceListModel) View() string {
 	case StateFetching:
 		return fmt.Sprintf("\n\n   %s Fetching Api Resources...!\n\n", m.spinner.View())
 	case StateCompleted:
-		m.list.Title = "Api Resources"
+		m.list.Title = m.title()
 		return m.styles.List.Render(m.list.View())
 	case StateApiResourceSelected:
 		return m.styles.List.Render(m.scopeList.View())
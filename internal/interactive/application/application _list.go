@@ -9,6 +9,7 @@ import (
 	"github.com/charmbracelet/bubbles/spinner"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/shashimalcse/asgardeo-cli/internal/api"
 	"github.com/shashimalcse/asgardeo-cli/internal/core"
 	"github.com/shashimalcse/asgardeo-cli/internal/models"
 	"github.com/shashimalcse/asgardeo-cli/internal/tui"
@@ -31,15 +32,40 @@ type ApplicationListModel struct {
 	state         ApplicationListState
 	stateError    error
 	list          list.Model
+	paginator     *api.Paginator[models.Application]
+	limit         int
+	fetched       int
+	total         int
+	hasNext       bool
+	loading       bool
 }
 
-func NewApplicationListModel(cli *core.CLI) *ApplicationListModel {
+// applicationPage is a page of applications fetched by the paginator, with the state of the
+// paginator after the fetch. The paginator is only read by the fetching command, so the model
+// keeps its own copy of that state.
+type applicationPage struct {
+	applications []models.Application
+	total        int
+	hasNext      bool
+}
+
+// fetchMoreThreshold is how close to the end of the list the cursor gets before the next page is fetched.
+const fetchMoreThreshold = 5
+
+// NewApplicationListModel returns a model listing the applications of the paginator, fetching the
+// next page as the cursor reaches the end of the list. A limit of zero lists every application.
+func NewApplicationListModel(cli *core.CLI, paginator *api.Paginator[models.Application], limit int) *ApplicationListModel {
 
 	return &ApplicationListModel{
-		styles:  tui.DefaultStyles(),
-		spinner: newSpinner(),
-		cli:     cli,
-		state:   StateFetching,
+		styles:    tui.DefaultStyles(),
+		spinner:   newSpinner(),
+		cli:       cli,
+		state:     StateFetching,
+		paginator: paginator,
+		limit:     limit,
+		total:     -1,
+		hasNext:   true,
+		loading:   true,
 	}
 
 }
@@ -54,11 +80,35 @@ func newSpinner() spinner.Model {
 func (m *ApplicationListModel) fetchApplications() tea.Msg {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
-	appsList, err := m.cli.API.Application.List(ctx)
+	applications, err := m.paginator.Next(ctx)
 	if err != nil {
 		return err
 	}
-	return appsList
+	return applicationPage{applications: applications, total: m.paginator.TotalResults(), hasNext: m.paginator.HasNext()}
+}
+
+// hasMore reports whether more applications can be fetched within the limit.
+func (m *ApplicationListModel) hasMore() bool {
+	return m.hasNext && (m.limit <= 0 || m.fetched < m.limit)
+}
+
+// fetchMore fetches the next page when the cursor is close to the end of the list.
+func (m *ApplicationListModel) fetchMore() tea.Cmd {
+	if m.loading || !m.hasMore() || m.list.FilterState() != list.Unfiltered {
+		return nil
+	}
+	if m.list.Index() < len(m.list.Items())-fetchMoreThreshold {
+		return nil
+	}
+	m.loading = true
+	return m.fetchApplications
+}
+
+func (m *ApplicationListModel) title() string {
+	if m.hasMore() && m.total > m.fetched {
+		return fmt.Sprintf("Applications (%d of %d)", m.fetched, m.total)
+	}
+	return "Applications"
 }
 
 // Init initializes the model and returns the initial command.
@@ -76,16 +126,28 @@ func (m *ApplicationListModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if msg.Type == tea.KeyCtrlC || (msg.Type == tea.KeyRunes && msg.String() == "q") {
 			return m, tea.Quit
 		}
-	case *models.ApplicationList:
+	case applicationPage:
+		m.loading = false
+		m.total = msg.total
+		m.hasNext = msg.hasNext
+		page := msg.applications
+		if m.limit > 0 && m.fetched+len(page) > m.limit {
+			page = page[:m.limit-m.fetched]
+		}
+		m.fetched += len(page)
 		var applications []list.Item
-		for _, app := range msg.Applications {
+		for _, app := range page {
 			applications = append(applications, tui.NewItem(app.Name, app.ID))
 		}
-		m.list = list.New(applications, list.NewDefaultDelegate(), 0, 0)
-		h, v := m.styles.List.GetFrameSize()
-		m.list.SetSize(m.width-h, m.height-v)
-		m.state = StateCompleted
-		return m, nil
+		if m.state == StateFetching {
+			m.list = list.New(applications, list.NewDefaultDelegate(), 0, 0)
+			h, v := m.styles.List.GetFrameSize()
+			m.list.SetSize(m.width-h, m.height-v)
+			m.state = StateCompleted
+			return m, m.fetchMore()
+		}
+		cmd := m.list.SetItems(append(m.list.Items(), applications...))
+		return m, tea.Batch(cmd, m.fetchMore())
 	case error:
 		m.state = StateError
 		m.stateError = msg
@@ -96,11 +158,13 @@ func (m *ApplicationListModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	}
 
 	var cmd tea.Cmd
+	var fetchCmd tea.Cmd
 	if m.state == StateCompleted {
 		m.list, _ = m.list.Update(msg)
+		fetchCmd = m.fetchMore()
 	}
 	m.spinner, cmd = m.spinner.Update(msg)
-	return m, cmd
+	return m, tea.Batch(cmd, fetchCmd)
 }
 
 func (m *ApplicationListModel) View() string {
@@ -108,7 +172,7 @@ func (m *ApplicationListModel) View() string {
 	case StateFetching:
 		return fmt.Sprintf("\n\n   %s Fetching applications...!\n\n", m.spinner.View())
 	case StateCompleted:
-		m.list.Title = "Applications"
+		m.list.Title = m.title()
 		return m.styles.List.Render(m.list.View())
 	case StateError:
 		return fmt.Sprint(m.stateError.Error())
@@ -51,6 +51,7 @@ type ApplicationCreateModel struct {
 	currentQuestionIndex int
 	applicationType      ApplicationType
 	output               string
+	credentials          *core.ApplicationCredentials
 }
 
 func NewApplicationCreateModel(cli *core.CLI) *ApplicationCreateModel {
@@ -98,6 +99,17 @@ func (m *ApplicationCreateModel) initQuestions() []tui.Question {
 			tui.NewQuestion("Assertion consumer service URLs", "Assertion consumer service URLs", tui.ShortQuestion),
 			tui.NewQuestion("Are you sure you want to create the application? (Y/n)", "Are you sure you want to create the application? (Y/n)", tui.ShortQuestion),
 		}
+	} else if m.applicationType == Mobile {
+		m.questions = []tui.Question{
+			tui.NewQuestion("Name", "Name", tui.ShortQuestion),
+			tui.NewQuestion("Authorized redirect URL", "Authorized redirect URL", tui.ShortQuestion),
+			tui.NewQuestion("Are you sure you want to create the application? (Y/n)", "Are you sure you want to create the application? (Y/n)", tui.ShortQuestion),
+		}
+	} else if m.applicationType == M2M {
+		m.questions = []tui.Question{
+			tui.NewQuestion("Name", "Name", tui.ShortQuestion),
+			tui.NewQuestion("Are you sure you want to create the application? (Y/n)", "Are you sure you want to create the application? (Y/n)", tui.ShortQuestion),
+		}
 	}
 	return nil
 }
@@ -163,7 +175,11 @@ func (m *ApplicationCreateModel) handleKeyEnter() (tea.Model, tea.Cmd) {
 					m.output = "Invalid protocol. Please enter OIDC or SAML"
 					return m, tea.Quit
 				}
+				// Keep the answers given so far, as the questions are rebuilt for the chosen protocol.
+				name := m.questions[0].Answer
 				m.initQuestions()
+				m.questions[0].Answer = name
+				m.questions[1].Answer = protocol
 			}
 			m.NextQuestion()
 		}
@@ -179,7 +195,10 @@ func (m *ApplicationCreateModel) handleKeyEnter() (tea.Model, tea.Cmd) {
 				m.output = "Error creating application!"
 			} else {
 				m.state = StateCreatingCompleted
-				m.output = "Application created successfully!"
+				m.output = "Application created successfully!\n"
+				if m.credentials != nil {
+					m.output += "\n" + m.credentials.Summary()
+				}
 			}
 		} else {
 			m.output = "Application creation cancelled."
@@ -207,7 +226,7 @@ func (m *ApplicationCreateModel) View() string {
 	case StateCreatingInProgress:
 		return fmt.Sprintf("\n\n   %s Creating application...\n\n", m.spinner.View())
 	case StateCreatingCompleted:
-		return "Application created successfully!"
+		return m.output + "\nPress ctrl+c to exit."
 	case StateCreatingError:
 		return fmt.Sprintf("Error creating application: %v", m.stateError)
 	}
@@ -241,120 +260,43 @@ func (m *ApplicationCreateModel) NextQuestion() {
 
 func (m *ApplicationCreateModel) createApplications() error {
 
-	application := map[string]interface{}{
-		"name": m.questions[0].Answer,
-		"advancedConfigurations": map[string]interface{}{
-			"discoverableByEndUsers": false,
-			"skipLogoutConsent":      true,
-			"skipLoginConsent":       true,
-		},
-		"authenticationSequence": map[string]interface{}{
-			"type": "DEFAULT",
-			"steps": []interface{}{
-				map[string]interface{}{
-					"id": 1,
-					"options": []interface{}{
-						map[string]interface{}{
-							"idp":           "LOCAL",
-							"authenticator": "basic",
-						},
-					},
-				},
-			},
-		},
-		"associatedRoles": map[string]interface{}{
-			"allowedAudience": "APPLICATION",
-			"roles":           []string{},
-		},
+	inputs := core.ApplicationCreateInputs{Name: m.questions[0].Answer}
+	switch m.applicationType {
+	case SinglePage:
+		inputs.Template = core.TemplateSinglePage
+		inputs.RedirectURLs = splitAnswer(m.questions[1].Answer)
+	case TraditionalOidc:
+		inputs.Template = core.TemplateTraditionalOIDC
+		inputs.RedirectURLs = splitAnswer(m.questions[2].Answer)
+	case TraditionalSaml:
+		inputs.Template = core.TemplateTraditionalSAML
+		inputs.Issuer = m.questions[2].Answer
+		inputs.AssertionConsumerURLs = splitAnswer(m.questions[3].Answer)
+	case Mobile:
+		inputs.Template = core.TemplateMobile
+		inputs.RedirectURLs = splitAnswer(m.questions[1].Answer)
+	case M2M:
+		inputs.Template = core.TemplateM2M
 	}
-
-	if m.applicationType == SinglePage {
-		application["templateId"] = "6a90e4b0-fbff-42d7-bfde-1efd98f07cd7"
-		application["inboundProtocolConfiguration"] = map[string]interface{}{
-			"oidc": map[string]interface{}{
-				"accessToken": map[string]interface{}{
-					"applicationAccessTokenExpiryInSeconds": 3600,
-					"bindingType":                           "sso-session",
-					"revokeTokensWhenIDPSessionTerminated":  true,
-					"type":                                  "Default",
-					"userAccessTokenExpiryInSeconds":        3600,
-					"validateTokenBinding":                  false,
-				},
-				"allowedOrigins": []string{m.questions[1].Answer},
-				"callbackURLs":   []string{m.questions[1].Answer},
-				"grantTypes":     []string{"authorization_code", "refresh_token"},
-				"pkce": map[string]interface{}{
-					"mandatory":                      true,
-					"supportPlainTransformAlgorithm": false,
-				},
-				"publicClient": true,
-				"refreshToken": map[string]interface{}{
-					"expiryInSeconds":   86400,
-					"renewRefreshToken": true,
-				},
-			},
-		}
-		application["claimConfiguration"] = map[string]interface{}{
-			"dialect": "LOCAL",
-			"requestedClaims": []interface{}{
-				map[string]interface{}{
-					"claim": map[string]interface{}{
-						"uri": "http://wso2.org/claims/username",
-					},
-				},
-			},
-		}
-	} else if m.applicationType == TraditionalOidc {
-		if m.questions[1].Answer == OIDC {
-			application["templateId"] = "b9c5e11e-fc78-484b-9bec-015d247561b8"
-			application["inboundProtocolConfiguration"] = map[string]interface{}{
-				"oidc": map[string]interface{}{
-					"allowedOrigins": []string{},
-					"callbackURLs":   []string{m.questions[2].Answer},
-					"grantTypes":     []string{"authorization_code"},
-					"publicClient":   false,
-					"refreshToken": map[string]interface{}{
-						"expiryInSeconds": 86400,
-					},
-				},
-			}
-			application["claimConfiguration"] = map[string]interface{}{
-				"dialect": "LOCAL",
-				"requestedClaims": []interface{}{
-					map[string]interface{}{
-						"claim": map[string]interface{}{
-							"uri": "http://wso2.org/claims/username",
-						},
-					},
-				},
-			}
-		}
-		if m.questions[1].Answer == SAML {
-			application["templateId"] = "776a73da-fd8e-490b-84ff-93009f8ede85"
-			application["inboundProtocolConfiguration"] = map[string]interface{}{
-				"saml": map[string]interface{}{
-					"manualConfiguration": map[string]interface{}{
-						"issuer":                "https://localhost:9443/oauth2/token",
-						"assertionConsumerUrls": []string{},
-						"attributeProfile": map[string]interface{}{
-							"alwaysIncludeAttributesInResponse": true,
-							"enabled":                           true,
-						},
-						"singleLogoutProfile": map[string]interface{}{
-							"enabled":      true,
-							"logoutMethod": "BACKCHANNEL",
-							"idpInitiatedSingleLogout": map[string]interface{}{
-								"enabled": false,
-							},
-						},
-					},
-				},
-			}
-		}
-	}
-	err := m.cli.API.Application.Create(context.Background(), application)
+	ctx := context.Background()
+	application, err := core.CreateApplication(ctx, m.cli, inputs)
 	if err != nil {
 		return err
 	}
+	m.credentials, err = core.GetApplicationCredentials(ctx, m.cli, application)
+	if err != nil {
+		return fmt.Errorf("application %s was created but its credentials could not be retrieved: %w", application.ID, err)
+	}
 	return nil
 }
+
+// splitAnswer splits a comma separated answer into its values.
+func splitAnswer(answer string) []string {
+	var values []string
+	for _, value := range strings.Split(answer, ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
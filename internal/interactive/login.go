@@ -9,6 +9,7 @@ import (
 	"github.com/charmbracelet/lipgloss"
 	"github.com/pkg/browser"
 	"github.com/shashimalcse/asgardeo-cli/internal/auth"
+	"github.com/shashimalcse/asgardeo-cli/internal/config"
 	"github.com/shashimalcse/asgardeo-cli/internal/core"
 	"github.com/shashimalcse/asgardeo-cli/internal/models"
 	"github.com/shashimalcse/asgardeo-cli/internal/tui"
@@ -53,16 +54,19 @@ type LoginModel struct {
 	stateMessage        string
 	deviceFlowState     auth.State
 	outputResult        models.OutputResult
+	server              string
 }
 
-// NewLoginModel creates and initializes a new LoginModel
-func NewLoginModel(cli *core.CLI) *LoginModel {
+// NewLoginModel creates and initializes a new LoginModel. The server URL is asked
+// for during the login unless it is already given.
+func NewLoginModel(cli *core.CLI, server string) *LoginModel {
 	return &LoginModel{
 		styles:       tui.DefaultStyles(),
 		spinner:      newSpinner(),
 		loginOptions: newLoginOptions(),
 		cli:          cli,
 		state:        StateNotStarted,
+		server:       server,
 	}
 }
 
@@ -216,6 +220,9 @@ func (m *LoginModel) initQuestions() {
 			tui.NewQuestion("client id", "Client ID", tui.ShortQuestion),
 			tui.NewQuestion("client secret", "Client Secret", tui.ShortSecretQuestion),
 		}
+		if m.server == "" {
+			m.questions = append(m.questions, tui.NewQuestion("server", "Server URL (default: "+config.DefaultServer+")", tui.ShortQuestion))
+		}
 	} else {
 		m.questions = []tui.Question{
 			tui.NewQuestion("tenant", "Tenant Domain", tui.ShortQuestion),
@@ -278,11 +285,16 @@ func (m *LoginModel) NextQuestion() {
 
 func (m *LoginModel) runLoginAsMachine() error {
 
+	server := m.server
+	if len(m.questions) > 3 {
+		server = m.questions[3].Answer
+	}
 	err := core.AuthenticateWithClientCredentials(
 		core.LoginInputs{
 			Tenant:       m.questions[0].Answer,
 			ClientID:     m.questions[1].Answer,
 			ClientSecret: m.questions[2].Answer,
+			Server:       server,
 		}, m.cli)
 	return err
 }
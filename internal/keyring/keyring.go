@@ -12,6 +12,7 @@ const (
 	secretRefreshToken                = "IS CLI Refresh Token"
 	secretClientSecret                = "IS CLI Client Secret"
 	secretAccessToken                 = "IS CLI Access Token"
+	secretOrganizationAccessToken     = "IS CLI Organization Access Token"
 	secretAccessTokenChunkSizeInBytes = 2048
 
 	// Access tokens have no size limit, but should be smaller than (50*2048) bytes.
@@ -63,6 +64,10 @@ func DeleteSecretsForTenant(tenant string) error {
 		}
 	}
 
+	if err := DeleteOrganizationAccessToken(tenant); err != nil {
+		multiErrors = append(multiErrors, fmt.Sprintf("failed to delete organization access token from keyring: %s", err))
+	}
+
 	if len(multiErrors) == 0 {
 		return nil
 	}
@@ -71,23 +76,64 @@ func DeleteSecretsForTenant(tenant string) error {
 }
 
 func StoreAccessToken(tenant, value string) error {
+	return storeChunkedSecret(secretAccessToken, tenant, value)
+}
+
+func GetAccessToken(tenant string) (string, error) {
+	return getChunkedSecret(secretAccessToken, tenant)
+}
+
+// StoreOrganizationAccessToken stores the access token of the organization a tenant is switched to.
+func StoreOrganizationAccessToken(tenant, value string) error {
+	return storeChunkedSecret(secretOrganizationAccessToken, tenant, value)
+}
+
+// GetOrganizationAccessToken retrieves the access token of the organization a tenant is switched to.
+func GetOrganizationAccessToken(tenant string) (string, error) {
+	return getChunkedSecret(secretOrganizationAccessToken, tenant)
+}
+
+// DeleteOrganizationAccessToken deletes the access token of the organization a tenant is switched to.
+func DeleteOrganizationAccessToken(tenant string) error {
+	for i := 0; i < secretAccessTokenMaxChunks; i++ {
+		if err := keyring.Delete(fmt.Sprintf("%s %d", secretOrganizationAccessToken, i), tenant); err != nil {
+			if errors.Is(err, keyring.ErrNotFound) {
+				return nil
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+func storeChunkedSecret(secret, tenant, value string) error {
 	chunks := chunk(value, secretAccessTokenChunkSizeInBytes)
 
 	for i := 0; i < len(chunks); i++ {
-		err := keyring.Set(fmt.Sprintf("%s %d", secretAccessToken, i), tenant, chunks[i])
+		err := keyring.Set(fmt.Sprintf("%s %d", secret, i), tenant, chunks[i])
 		if err != nil {
 			return err
 		}
 	}
 
+	// Remove chunks left behind by a previously stored, longer access token.
+	for i := len(chunks); i < secretAccessTokenMaxChunks; i++ {
+		if err := keyring.Delete(fmt.Sprintf("%s %d", secret, i), tenant); err != nil {
+			if errors.Is(err, keyring.ErrNotFound) {
+				break
+			}
+			return err
+		}
+	}
+
 	return nil
 }
 
-func GetAccessToken(tenant string) (string, error) {
+func getChunkedSecret(secret, tenant string) (string, error) {
 	var accessToken string
 
 	for i := 0; i < secretAccessTokenMaxChunks; i++ {
-		a, err := keyring.Get(fmt.Sprintf("%s %d", secretAccessToken, i), tenant)
+		a, err := keyring.Get(fmt.Sprintf("%s %d", secret, i), tenant)
 		// Only return if we have pulled more than 1 item from the keyring, otherwise this will be
 		// a valid "secret not found in keyring".
 		if err == keyring.ErrNotFound && i > 0 {
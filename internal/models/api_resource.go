@@ -2,7 +2,7 @@ package models
 
 type APIResourceList struct {
 	TotalResults int           `json:"totalResults"`
-	Links        []interface{} `json:"links"`
+	Links        []Link        `json:"links"`
 	APIResources []APIResource `json:"apiResources"`
 }
 
@@ -10,6 +10,7 @@ type APIResource struct {
 	ID                    string     `json:"id"`
 	Name                  string     `json:"name"`
 	Identifier            string     `json:"identifier"`
+	Description           string     `json:"description,omitempty"`
 	Type                  string     `json:"type"`
 	RequiresAuthorization bool       `json:"requiresAuthorization"`
 	Scopes                []Scope    `json:"scopes"`
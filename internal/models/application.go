@@ -1,22 +1,23 @@
 package models
 
 type Application struct {
-	ID                           string                       `json:"id,omitempty"`
-	Name                         string                       `json:"name,omitempty"`
-	LogoutReturnURL              string                       `json:"logoutReturnUrl,omitempty"`
-	ClientID                     string                       `json:"clientId,omitempty"`
-	Issuer                       string                       `json:"issuer,omitempty"`
-	Realm                        string                       `json:"realm,omitempty"`
-	TemplateID                   string                       `json:"templateId,omitempty"`
-	IsManagementApp              bool                         `json:"isManagementApp,omitempty"`
-	AssociatedRoles              AssociatedRoles              `json:"associatedRoles,omitempty"`
-	ClaimConfiguration           ClaimConfiguration           `json:"claimConfiguration,omitempty"`
-	InboundProtocols             []InboundProtocol            `json:"inboundProtocols,omitempty"`
-	InboundProtocolConfiguration InboundProtocolConfiguration `json:"inboundProtocolConfiguration,omitempty"`
-	AuthenticationSeq            AuthenticationSequence       `json:"authenticationSequence,omitempty"`
-	AdvancedConfig               AdvancedConfigurations       `json:"advancedConfigurations,omitempty"`
-	ProvisioningConfig           ProvisioningConfigurations   `json:"provisioningConfigurations,omitempty"`
-	Access                       string                       `json:"access,omitempty"`
+	ID                           string                        `json:"id,omitempty"`
+	Name                         string                        `json:"name,omitempty"`
+	Description                  string                        `json:"description,omitempty"`
+	LogoutReturnURL              string                        `json:"logoutReturnUrl,omitempty"`
+	ClientID                     string                        `json:"clientId,omitempty"`
+	Issuer                       string                        `json:"issuer,omitempty"`
+	Realm                        string                        `json:"realm,omitempty"`
+	TemplateID                   string                        `json:"templateId,omitempty"`
+	IsManagementApp              bool                          `json:"isManagementApp,omitempty"`
+	AssociatedRoles              *AssociatedRoles              `json:"associatedRoles,omitempty"`
+	ClaimConfiguration           *ClaimConfiguration           `json:"claimConfiguration,omitempty"`
+	InboundProtocols             []InboundProtocol             `json:"inboundProtocols,omitempty"`
+	InboundProtocolConfiguration *InboundProtocolConfiguration `json:"inboundProtocolConfiguration,omitempty"`
+	AuthenticationSeq            *AuthenticationSequence       `json:"authenticationSequence,omitempty"`
+	AdvancedConfig               *AdvancedConfigurations       `json:"advancedConfigurations,omitempty"`
+	ProvisioningConfig           *ProvisioningConfigurations   `json:"provisioningConfigurations,omitempty"`
+	Access                       string                        `json:"access,omitempty"`
 }
 
 type ApplicationList struct {
@@ -38,16 +39,28 @@ type AssociatedRoles struct {
 }
 
 type AssociatedRole struct {
-	ID   string `json:"id"`
+	ID   string `json:"id,omitempty"`
 	Name string `json:"name"`
 }
 
 type ClaimConfiguration struct {
-	Dialect         string        `json:"dialect"`
-	ClaimMappings   []interface{} `json:"claimMappings"`
-	RequestedClaims []interface{} `json:"requestedClaims"`
-	Subject         Subject       `json:"subject"`
-	Role            Role          `json:"role"`
+	Dialect         string           `json:"dialect"`
+	ClaimMappings   []ClaimMapping   `json:"claimMappings"`
+	RequestedClaims []RequestedClaim `json:"requestedClaims"`
+	Subject         Subject          `json:"subject"`
+	Role            Role             `json:"role"`
+}
+
+// ClaimMapping maps a claim of an application using the custom dialect to a local claim.
+type ClaimMapping struct {
+	ApplicationClaim string `json:"applicationClaim"`
+	LocalClaim       Claim  `json:"localClaim"`
+}
+
+// RequestedClaim is a local claim released to an application.
+type RequestedClaim struct {
+	Claim     Claim `json:"claim"`
+	Mandatory bool  `json:"mandatory"`
 }
 
 type Subject struct {
@@ -73,10 +86,14 @@ type InboundProtocol struct {
 }
 
 type InboundProtocolConfiguration struct {
-	OIDC OIDC `json:"oidc"`
+	OIDC *OIDC `json:"oidc,omitempty"`
+	SAML *SAML `json:"saml,omitempty"`
 }
 
 type OIDC struct {
+	ClientID       string       `json:"clientId,omitempty"`
+	ClientSecret   string       `json:"clientSecret,omitempty"`
+	State          string       `json:"state,omitempty"`
 	AccessToken    AccessToken  `json:"accessToken"`
 	GrantTypes     []string     `json:"grantTypes"`
 	AllowedOrigins []string     `json:"allowedOrigins"`
@@ -86,6 +103,14 @@ type OIDC struct {
 	RefreshToken   RefreshToken `json:"refreshToken"`
 }
 
+type SAML struct {
+	Issuer                      string   `json:"issuer"`
+	ServiceProviderQualifier    string   `json:"serviceProviderQualifier,omitempty"`
+	AssertionConsumerURLs       []string `json:"assertionConsumerUrls"`
+	DefaultAssertionConsumerURL string   `json:"defaultAssertionConsumerUrl,omitempty"`
+	IdpEntityIDAlias            string   `json:"idpEntityIdAlias,omitempty"`
+}
+
 type PKCE struct {
 	Mandatory                      bool `json:"mandatory"`
 	SupportPlainTransformAlgorithm bool `json:"supportPlainTransformAlgorithm"`
@@ -111,6 +136,7 @@ type AuthenticationSequence struct {
 	RequestPathAuthenticators []interface{} `json:"requestPathAuthenticators"`
 	SubjectStepID             int           `json:"subjectStepId"`
 	AttributeStepID           int           `json:"attributeStepId"`
+	Script                    string        `json:"script,omitempty"`
 }
 
 type Step struct {
@@ -0,0 +1,13 @@
+package models
+
+// Authenticator is a local authenticator or an identity provider of the tenant that can be used in
+// the login flow of an application.
+type Authenticator struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	DisplayName string   `json:"displayName"`
+	IsEnabled   bool     `json:"isEnabled"`
+	Type        string   `json:"type"`
+	Tags        []string `json:"tags,omitempty"`
+	Self        string   `json:"self,omitempty"`
+}
@@ -0,0 +1,30 @@
+package models
+
+// AuthorizedAPI is an api resource that an application is authorized to access, with the scopes
+// the application can request.
+type AuthorizedAPI struct {
+	ID               string            `json:"id"`
+	Identifier       string            `json:"identifier"`
+	DisplayName      string            `json:"displayName"`
+	Type             string            `json:"type,omitempty"`
+	PolicyID         string            `json:"policyId"`
+	AuthorizedScopes []AuthorizedScope `json:"authorizedScopes"`
+}
+
+type AuthorizedScope struct {
+	Name        string `json:"name"`
+	DisplayName string `json:"displayName,omitempty"`
+}
+
+// AuthorizedAPICreate authorizes an application to access an api resource.
+type AuthorizedAPICreate struct {
+	ID               string   `json:"id"`
+	PolicyIdentifier string   `json:"policyIdentifier"`
+	Scopes           []string `json:"scopes"`
+}
+
+// AuthorizedAPIPatch changes the scopes of an authorized api resource.
+type AuthorizedAPIPatch struct {
+	AddedScopes   []string `json:"addedScopes"`
+	RemovedScopes []string `json:"removedScopes"`
+}
@@ -0,0 +1,64 @@
+package models
+
+import (
+	"encoding/json"
+	"strconv"
+)
+
+const (
+	SCIMBulkRequestSchema  = "urn:ietf:params:scim:api:messages:2.0:BulkRequest"
+	SCIMBulkResponseSchema = "urn:ietf:params:scim:api:messages:2.0:BulkResponse"
+)
+
+type BulkRequest struct {
+	Schemas      []string        `json:"schemas"`
+	FailOnErrors int             `json:"failOnErrors,omitempty"`
+	Operations   []BulkOperation `json:"Operations"`
+}
+
+type BulkOperation struct {
+	Method string      `json:"method"`
+	BulkID string      `json:"bulkId,omitempty"`
+	Path   string      `json:"path"`
+	Data   interface{} `json:"data,omitempty"`
+}
+
+type BulkResponse struct {
+	Schemas    []string                `json:"schemas,omitempty"`
+	Operations []BulkOperationResponse `json:"Operations"`
+}
+
+type BulkOperationResponse struct {
+	Method   string          `json:"method"`
+	BulkID   string          `json:"bulkId,omitempty"`
+	Location string          `json:"location,omitempty"`
+	Status   BulkStatus      `json:"status"`
+	Response json.RawMessage `json:"response,omitempty"`
+}
+
+// BulkStatus is the HTTP status of a bulk operation. The SCIM specification sends it as a string,
+// while some servers send a number or an object with a code, so every form is accepted.
+type BulkStatus int
+
+func (s *BulkStatus) UnmarshalJSON(data []byte) error {
+	var code struct {
+		Code json.Number `json:"code"`
+	}
+	if err := json.Unmarshal(data, &code); err == nil {
+		data = []byte(code.Code)
+	}
+	var value json.Number
+	if err := json.Unmarshal(data, &value); err != nil {
+		var text string
+		if err := json.Unmarshal(data, &text); err != nil {
+			return err
+		}
+		value = json.Number(text)
+	}
+	parsed, err := strconv.Atoi(value.String())
+	if err != nil {
+		return err
+	}
+	*s = BulkStatus(parsed)
+	return nil
+}
@@ -0,0 +1,43 @@
+package models
+
+// ClaimDialect is a set of claims, such as the local claims of the tenant or the claims of OIDC
+// and SCIM, which are mapped to the local claims.
+type ClaimDialect struct {
+	ID         string `json:"id"`
+	DialectURI string `json:"dialectURI"`
+	Links      []Link `json:"link,omitempty"`
+}
+
+// LocalClaim is a user attribute of the tenant.
+type LocalClaim struct {
+	ID                 string             `json:"id,omitempty"`
+	ClaimURI           string             `json:"claimURI"`
+	DisplayName        string             `json:"displayName"`
+	Description        string             `json:"description"`
+	DisplayOrder       int                `json:"displayOrder"`
+	ReadOnly           bool               `json:"readOnly"`
+	Required           bool               `json:"required"`
+	SupportedByDefault bool               `json:"supportedByDefault"`
+	RegEx              string             `json:"regEx,omitempty"`
+	AttributeMapping   []AttributeMapping `json:"attributeMapping"`
+	Properties         []ClaimProperty    `json:"properties"`
+}
+
+// AttributeMapping maps a local claim to an attribute of a user store.
+type AttributeMapping struct {
+	MappedAttribute string `json:"mappedAttribute"`
+	Userstore       string `json:"userstore"`
+}
+
+type ClaimProperty struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// ExternalClaim is a claim of a dialect other than the local dialect, mapped to a local claim.
+type ExternalClaim struct {
+	ID                  string `json:"id,omitempty"`
+	ClaimURI            string `json:"claimURI"`
+	ClaimDialectURI     string `json:"claimDialectURI,omitempty"`
+	MappedLocalClaimURI string `json:"mappedLocalClaimURI"`
+}
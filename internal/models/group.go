@@ -0,0 +1,26 @@
+package models
+
+const SCIMGroupSchema = "urn:ietf:params:scim:schemas:core:2.0:Group"
+
+type Group struct {
+	ID          string        `json:"id,omitempty"`
+	Schemas     []string      `json:"schemas,omitempty"`
+	DisplayName string        `json:"displayName,omitempty"`
+	Members     []GroupMember `json:"members,omitempty"`
+	Meta        *Meta         `json:"meta,omitempty"`
+}
+
+type GroupMember struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+	Ref     string `json:"$ref,omitempty"`
+	Type    string `json:"type,omitempty"`
+}
+
+type GroupList struct {
+	Schemas      []string `json:"schemas,omitempty"`
+	TotalResults int      `json:"totalResults"`
+	StartIndex   int      `json:"startIndex"`
+	ItemsPerPage int      `json:"itemsPerPage"`
+	Resources    []Group  `json:"Resources"`
+}
@@ -0,0 +1,70 @@
+package models
+
+type IdentityProviderList struct {
+	TotalResults      int                `json:"totalResults"`
+	StartIndex        int                `json:"startIndex"`
+	Count             int                `json:"count"`
+	IdentityProviders []IdentityProvider `json:"identityProviders"`
+	Links             []Link             `json:"links"`
+}
+
+type IdentityProvider struct {
+	ID                      string                   `json:"id,omitempty"`
+	Name                    string                   `json:"name"`
+	Description             string                   `json:"description,omitempty"`
+	Image                   string                   `json:"image,omitempty"`
+	IsEnabled               bool                     `json:"isEnabled"`
+	IsPrimary               bool                     `json:"isPrimary,omitempty"`
+	IsFederationHub         bool                     `json:"isFederationHub,omitempty"`
+	HomeRealmIdentifier     string                   `json:"homeRealmIdentifier,omitempty"`
+	Alias                   string                   `json:"alias,omitempty"`
+	IdpIssuerName           string                   `json:"idpIssuerName,omitempty"`
+	TemplateID              string                   `json:"templateId,omitempty"`
+	Certificate             *IdentityProviderCert    `json:"certificate,omitempty"`
+	FederatedAuthenticators *FederatedAuthenticators `json:"federatedAuthenticators,omitempty"`
+	Provisioning            *IdPProvisioning         `json:"provisioning,omitempty"`
+	Self                    string                   `json:"self,omitempty"`
+}
+
+type IdentityProviderCert struct {
+	Certificates []string `json:"certificates,omitempty"`
+	JwksURI      string   `json:"jwksUri,omitempty"`
+}
+
+type FederatedAuthenticators struct {
+	DefaultAuthenticatorID string                   `json:"defaultAuthenticatorId,omitempty"`
+	Authenticators         []FederatedAuthenticator `json:"authenticators"`
+}
+
+type FederatedAuthenticator struct {
+	AuthenticatorID string                  `json:"authenticatorId"`
+	Name            string                  `json:"name,omitempty"`
+	IsEnabled       bool                    `json:"isEnabled"`
+	IsDefault       bool                    `json:"isDefault"`
+	Properties      []AuthenticatorProperty `json:"properties,omitempty"`
+	Self            string                  `json:"self,omitempty"`
+}
+
+type AuthenticatorProperty struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+type IdPProvisioning struct {
+	JIT *JITProvisioning `json:"jit,omitempty"`
+}
+
+type JITProvisioning struct {
+	IsEnabled          bool   `json:"isEnabled"`
+	Scheme             string `json:"scheme,omitempty"`
+	Userstore          string `json:"userstore,omitempty"`
+	AssociateLocalUser bool   `json:"associateLocalUser"`
+}
+
+// IdentityProviderPatch is an operation of the patch of an identity provider, for example
+// {"operation": "REPLACE", "path": "/description", "value": "..."}.
+type IdentityProviderPatch struct {
+	Operation string      `json:"operation"`
+	Path      string      `json:"path"`
+	Value     interface{} `json:"value,omitempty"`
+}
@@ -0,0 +1,9 @@
+package models
+
+// OIDCScope is an OpenID Connect scope and the claims it releases to applications requesting it.
+type OIDCScope struct {
+	Name        string   `json:"name,omitempty"`
+	DisplayName string   `json:"displayName"`
+	Description string   `json:"description"`
+	Claims      []string `json:"claims"`
+}
@@ -0,0 +1,34 @@
+package models
+
+type OrganizationList struct {
+	Links         []Link         `json:"links"`
+	Organizations []Organization `json:"organizations"`
+}
+
+type Organization struct {
+	ID           string              `json:"id"`
+	Name         string              `json:"name"`
+	Description  string              `json:"description,omitempty"`
+	Status       string              `json:"status,omitempty"`
+	Type         string              `json:"type,omitempty"`
+	Created      string              `json:"created,omitempty"`
+	LastModified string              `json:"lastModified,omitempty"`
+	Parent       *OrganizationParent `json:"parent,omitempty"`
+	Ref          string              `json:"ref,omitempty"`
+}
+
+type OrganizationParent struct {
+	ID  string `json:"id"`
+	Ref string `json:"ref,omitempty"`
+}
+
+type OrganizationCreate struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	ParentID    string `json:"parentId,omitempty"`
+}
+
+type ApplicationShare struct {
+	ShareWithAllChildren bool     `json:"shareWithAllChildren"`
+	SharedOrganizations  []string `json:"sharedOrganizations,omitempty"`
+}
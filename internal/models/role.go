@@ -0,0 +1,43 @@
+package models
+
+const SCIMRoleSchema = "urn:ietf:params:scim:schemas:extension:2.0:Role"
+
+// RoleV2 is a role of the v2 roles API, which belongs to the organization or to an application.
+type RoleV2 struct {
+	ID          string           `json:"id,omitempty"`
+	Schemas     []string         `json:"schemas,omitempty"`
+	DisplayName string           `json:"displayName,omitempty"`
+	Audience    *RoleAudience    `json:"audience,omitempty"`
+	Permissions []RolePermission `json:"permissions,omitempty"`
+	Users       []RoleMember     `json:"users,omitempty"`
+	Groups      []RoleMember     `json:"groups,omitempty"`
+	Meta        *Meta            `json:"meta,omitempty"`
+}
+
+// RoleAudience is the organization or the application a role belongs to.
+type RoleAudience struct {
+	Value   string `json:"value"`
+	Type    string `json:"type"`
+	Display string `json:"display,omitempty"`
+}
+
+// RolePermission is a scope of an api resource granted by a role, identified by the scope name.
+type RolePermission struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+	Ref     string `json:"$ref,omitempty"`
+}
+
+type RoleMember struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+	Ref     string `json:"$ref,omitempty"`
+}
+
+type RoleV2List struct {
+	Schemas      []string `json:"schemas,omitempty"`
+	TotalResults int      `json:"totalResults"`
+	StartIndex   int      `json:"startIndex"`
+	ItemsPerPage int      `json:"itemsPerPage"`
+	Resources    []RoleV2 `json:"Resources"`
+}
@@ -0,0 +1,145 @@
+package models
+
+import (
+	"encoding/json"
+	"strconv"
+)
+
+const (
+	SCIMUserSchema           = "urn:ietf:params:scim:schemas:core:2.0:User"
+	SCIMEnterpriseUserSchema = "urn:ietf:params:scim:schemas:extension:enterprise:2.0:User"
+	SCIMWSO2UserSchema       = "urn:scim:wso2:schema"
+	SCIMListResponseSchema   = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	SCIMSearchRequestSchema  = "urn:ietf:params:scim:api:messages:2.0:SearchRequest"
+	SCIMPatchOpSchema        = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+)
+
+type User struct {
+	ID           string          `json:"id,omitempty"`
+	Schemas      []string        `json:"schemas,omitempty"`
+	UserName     string          `json:"userName,omitempty"`
+	Password     string          `json:"password,omitempty"`
+	Name         *UserName       `json:"name,omitempty"`
+	DisplayName  string          `json:"displayName,omitempty"`
+	NickName     string          `json:"nickName,omitempty"`
+	Locale       string          `json:"locale,omitempty"`
+	Active       *bool           `json:"active,omitempty"`
+	Emails       []MultiValue    `json:"emails,omitempty"`
+	PhoneNumbers []MultiValue    `json:"phoneNumbers,omitempty"`
+	Groups       []UserGroup     `json:"groups,omitempty"`
+	Roles        []MultiValue    `json:"roles,omitempty"`
+	Meta         *Meta           `json:"meta,omitempty"`
+	Enterprise   *EnterpriseUser `json:"urn:ietf:params:scim:schemas:extension:enterprise:2.0:User,omitempty"`
+	WSO2         *WSO2UserSchema `json:"urn:scim:wso2:schema,omitempty"`
+}
+
+type UserName struct {
+	GivenName  string `json:"givenName,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+	Formatted  string `json:"formatted,omitempty"`
+}
+
+// MultiValue is a SCIM multi-valued attribute. Servers may return plain strings instead of
+// objects, so both forms are accepted.
+type MultiValue struct {
+	Value   string `json:"value"`
+	Type    string `json:"type,omitempty"`
+	Display string `json:"display,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+func (m *MultiValue) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err == nil {
+		*m = MultiValue{Value: value}
+		return nil
+	}
+	type multiValue MultiValue
+	return json.Unmarshal(data, (*multiValue)(m))
+}
+
+type UserGroup struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+	Ref     string `json:"$ref,omitempty"`
+}
+
+type Meta struct {
+	ResourceType string `json:"resourceType,omitempty"`
+	Created      string `json:"created,omitempty"`
+	LastModified string `json:"lastModified,omitempty"`
+	Location     string `json:"location,omitempty"`
+}
+
+type EnterpriseUser struct {
+	EmployeeNumber string   `json:"employeeNumber,omitempty"`
+	CostCenter     string   `json:"costCenter,omitempty"`
+	Organization   string   `json:"organization,omitempty"`
+	Division       string   `json:"division,omitempty"`
+	Department     string   `json:"department,omitempty"`
+	Manager        *Manager `json:"manager,omitempty"`
+}
+
+type Manager struct {
+	Value       string `json:"value,omitempty"`
+	DisplayName string `json:"displayName,omitempty"`
+}
+
+// WSO2UserSchema holds the attributes of the WSO2 extension schema used for account management.
+type WSO2UserSchema struct {
+	AccountLocked      *SCIMBool `json:"accountLocked,omitempty"`
+	AccountState       string    `json:"accountState,omitempty"`
+	AskPassword        *SCIMBool `json:"askPassword,omitempty"`
+	ForcePasswordReset *SCIMBool `json:"forcePasswordReset,omitempty"`
+	EmailVerified      *SCIMBool `json:"emailVerified,omitempty"`
+	UserSource         string    `json:"userSourceId,omitempty"`
+}
+
+// SCIMBool is a boolean that the server may return as a string.
+type SCIMBool bool
+
+func (b *SCIMBool) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err == nil {
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		*b = SCIMBool(parsed)
+		return nil
+	}
+	var parsed bool
+	if err := json.Unmarshal(data, &parsed); err != nil {
+		return err
+	}
+	*b = SCIMBool(parsed)
+	return nil
+}
+
+type UserList struct {
+	Schemas      []string `json:"schemas,omitempty"`
+	TotalResults int      `json:"totalResults"`
+	StartIndex   int      `json:"startIndex"`
+	ItemsPerPage int      `json:"itemsPerPage"`
+	Resources    []User   `json:"Resources"`
+}
+
+type SearchRequest struct {
+	Schemas            []string `json:"schemas"`
+	Filter             string   `json:"filter,omitempty"`
+	Attributes         []string `json:"attributes,omitempty"`
+	ExcludedAttributes []string `json:"excludedAttributes,omitempty"`
+	StartIndex         int      `json:"startIndex,omitempty"`
+	Count              int      `json:"count,omitempty"`
+}
+
+type PatchRequest struct {
+	Schemas    []string         `json:"schemas"`
+	Operations []PatchOperation `json:"Operations"`
+}
+
+type PatchOperation struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path,omitempty"`
+	Value interface{} `json:"value,omitempty"`
+}